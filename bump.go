@@ -0,0 +1,321 @@
+package calver
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/shazib-summar/go-calver/internal"
+)
+
+// BumpOptions configures how Version.IncLevel increments a level.
+type BumpOptions struct {
+	// ResetLower resets the levels below the incremented level. Counters, i.e.
+	// <MAJOR>, <MINOR> and <MICRO>, reset to 0 keeping their zero padding.
+	// Quarters, months, weeks and days reset to 1 and hours and minutes to 0
+	// using the padding of the convention, so <0M> resets to 01 and <MM> to
+	// 1. A numeric modifier resets to 0 and any other modifier is kept.
+	// Conventions in optional sections, including modifiers, are cleared,
+	// which leaves the sections out.
+	ResetLower bool
+	// Numeric increments the level as a plain number, without rolling months,
+	// days and weeks over into the next month or year. For example, with the
+	// format <YYYY>.<0M> the minor level of 2025.12 becomes 2025.13.
+	Numeric bool
+}
+
+// IncLevel increments the given level of the version. The level is one of
+// "major", "minor", "micro" or "modifier" and is case insensitive. If the level
+// is not used in the format, the version is left unchanged. If the format uses
+// several conventions of the level, the first one is incremented, see
+// IncSegment to increment the others. The version is modified in place, see
+// Bump to get an incremented copy instead.
+//
+// Calendar levels roll over into the level above them unless the Numeric
+// option is used: months roll over into the next year, days into the next
+// month according to the length of the month, and ISO weeks into the next year
+// after week 52 or 53. Days only roll over if the format has a month and weeks
+// only if the format has a year. Likewise, quarters roll over into the next
+// year, days of the year into the next year after day 365 or 366, hours into
+// the next day and minutes into the next hour. Hours only roll over if the
+// format has a day or a day of the year and minutes only if it has an hour.
+// Days and weeks below the incremented level that no longer exist, such as
+// the 31st after incrementing the month of 2025.01.31, become the last day or
+// week of their month or year, so the result is still a valid date.
+//
+// A counter in an optional section that is absent counts as 0, so the micro
+// level of 2025.07.14 with the format <YYYY>.<0M>.<0D>[.<MICRO>] becomes 1.
+//
+// Example:
+//
+//	ver, err := calver.Parse("<YYYY>.<MINOR>.<MICRO>", "2025.3.7")
+//	if err != nil {
+//	    return err
+//	}
+//	err = ver.IncLevel("major", calver.BumpOptions{ResetLower: true})
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(ver.String()) // 2026.0.0
+//
+// It returns an error if the level is not recognized or if the value of the
+// level is not a number.
+func (c *Version) IncLevel(level string, opts BumpOptions) error {
+	level = strings.ToLower(level)
+	if !slices.Contains(internal.ValidLevels, level) {
+		return fmt.Errorf("unrecognized level: %q", level)
+	}
+
+	f, err := c.compiledFormat()
+	if err != nil {
+		return err
+	}
+	i, ok := f.levels[level]
+	if !ok {
+		return nil
+	}
+	return c.incSegment(f, i, opts)
+}
+
+// IncSegment increments the i-th segment of the version, see Segments. It is
+// like IncLevel but can also increment the segments that are not the first of
+// their level, e.g. the build counter of <YYYY>.<0M>.<0D>.<MICRO>. With the
+// ResetLower option, every segment after the i-th one is reset.
+//
+// Example:
+//
+//	ver, err := calver.Parse("<YYYY>.<0M>.<0D>.<MICRO>", "2025.07.14.3")
+//	if err != nil {
+//	    return err
+//	}
+//	err = ver.IncSegment(3, calver.BumpOptions{})
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(ver.String()) // 2025.07.14.4
+//
+// It returns an error if i is out of range or if the value of the segment is
+// not a number.
+func (c *Version) IncSegment(i int, opts BumpOptions) error {
+	f, err := c.compiledFormat()
+	if err != nil {
+		return err
+	}
+	if i < 0 || i >= len(f.segments) {
+		return fmt.Errorf("segment %d out of range: format %q has %d segments", i, f.raw, len(f.segments))
+	}
+	return c.incSegment(f, i, opts)
+}
+
+// Bump returns a copy of the version with the given level incremented as by
+// IncLevel, leaving the version itself unchanged. Unlike IncLevel, it can be
+// called on a version shared by several goroutines.
+//
+// Example:
+//
+//	ver, err := calver.Parse("<YYYY>.<MINOR>.<MICRO>", "2025.3.7")
+//	if err != nil {
+//	    return err
+//	}
+//	next, err := ver.Bump("minor", calver.BumpOptions{ResetLower: true})
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(ver, next) // 2025.3.7 2025.4.0
+//
+// It returns an error if the level is not recognized or if the value of the
+// level is not a number.
+func (c *Version) Bump(level string, opts BumpOptions) (*Version, error) {
+	bumped := c.Clone()
+	if err := bumped.IncLevel(level, opts); err != nil {
+		return nil, err
+	}
+	return bumped, nil
+}
+
+// BumpSegment is like Bump but increments the i-th segment of the version as
+// by IncSegment.
+//
+// It returns an error if i is out of range or if the value of the segment is
+// not a number.
+func (c *Version) BumpSegment(i int, opts BumpOptions) (*Version, error) {
+	bumped := c.Clone()
+	if err := bumped.IncSegment(i, opts); err != nil {
+		return nil, err
+	}
+	return bumped, nil
+}
+
+// incSegment increments the i-th segment of the version and resets the
+// segments after it if requested.
+func (c *Version) incSegment(f *Format, i int, opts BumpOptions) error {
+	if err := c.incConvention(f, i, opts.Numeric); err != nil {
+		return err
+	}
+	if !opts.Numeric {
+		c.clampCalendar(f, i)
+	}
+
+	if opts.ResetLower {
+		for j := i + 1; j < len(f.segments); j++ {
+			value := ""
+			if !f.segments[j].optional {
+				value = resetValue(f.segments[j].convention, c.segmentValue(f, j))
+			}
+			c.setSegmentValue(f, j, value)
+		}
+	}
+	return nil
+}
+
+// incConvention increments the i-th segment of the version, rolling calendar
+// segments over into the segment above them unless numeric is true.
+func (c *Version) incConvention(f *Format, i int, numeric bool) error {
+	con := f.segments[i].convention
+	if !numeric {
+		if carry, ok := rollover(c, f, i); ok {
+			c.setSegmentValue(f, i, formatCalendarValue(con, calendarStart(con)))
+			return c.incConvention(f, carry, numeric)
+		}
+	}
+
+	value, err := incValue(con, f.optionalValue(i, c.segmentValue(f, i)))
+	if err != nil {
+		return err
+	}
+	c.setSegmentValue(f, i, value)
+	return nil
+}
+
+// clampCalendar moves the days, weeks and days of the year after the i-th
+// segment back to the last day or week of their month or year if they are past
+// it, so incrementing the month of 2025.01.31 gives 2025.02.28 and the year of
+// 2026-W53 gives 2027-W52.
+func (c *Version) clampCalendar(f *Format, i int) {
+	year := 0
+	if y := f.calendarSegment(internal.KindYear, internal.KindShortYear); y >= 0 {
+		year, _ = internal.Year(f.segments[y].convention.Kind, c.segmentValue(f, y))
+	}
+	for j := i + 1; j < len(f.segments); j++ {
+		con := f.segments[j].convention
+		n, err := strconv.Atoi(c.segmentValue(f, j))
+		if err != nil {
+			continue
+		}
+		last := 0
+		switch con.Kind {
+		case internal.KindDay:
+			m := f.calendarSegment(internal.KindMonth)
+			if m < 0 {
+				continue
+			}
+			month, err := strconv.Atoi(c.segmentValue(f, m))
+			if err != nil || month < 1 || month > 12 {
+				continue
+			}
+			last = internal.DaysIn(year, month)
+		case internal.KindWeek:
+			if year != 0 {
+				last = internal.ISOWeeksIn(year)
+			}
+		case internal.KindDayOfYear:
+			if year != 0 {
+				last = internal.DaysInYear(year)
+			}
+		}
+		if last > 0 && n > last {
+			c.setSegmentValue(f, j, formatCalendarValue(con, last))
+		}
+	}
+}
+
+// incValue returns the value following value for the convention. Values are
+// incremented as numbers unless the convention is a custom convention with an
+// Increment function, whose result must match the regex of the convention.
+func incValue(con *internal.Convention, value string) (string, error) {
+	if con.Increment == nil {
+		return internal.IncWithPadding(value)
+	}
+	next, err := con.Increment(value)
+	if err != nil {
+		return "", err
+	}
+	if con.Pattern != nil && !con.Pattern.MatchString(next) {
+		return "", fmt.Errorf(
+			"incrementing %s value %q: %q does not match %s: %w",
+			con.Name, value, next, con.Regex, ErrInvalidConvention,
+		)
+	}
+	return next, nil
+}
+
+// rollover reports whether incrementing the i-th segment of the version rolls
+// over and returns the segment the increment is carried into.
+func rollover(c *Version, f *Format, i int) (int, bool) {
+	n, err := strconv.Atoi(c.segmentValue(f, i))
+	if err != nil {
+		return 0, false
+	}
+	// Months, quarters, weeks and days of the year carry into the year, or into
+	// the major level if there is none.
+	upper, hasUpper := f.levels[internal.KeyMajor]
+	year := 0
+	if y := f.calendarSegment(internal.KindYear, internal.KindShortYear); y >= 0 {
+		upper = y
+		year, _ = internal.Year(f.segments[y].convention.Kind, c.segmentValue(f, y))
+	}
+
+	switch f.segments[i].convention.Kind {
+	case internal.KindMonth:
+		return upper, hasUpper && n >= 12
+	case internal.KindQuarter:
+		return upper, hasUpper && n >= 4
+	case internal.KindWeek:
+		return upper, year != 0 && n >= internal.ISOWeeksIn(year)
+	case internal.KindDayOfYear:
+		return upper, year != 0 && n >= internal.DaysInYear(year)
+	case internal.KindDay:
+		m := f.calendarSegment(internal.KindMonth)
+		if m < 0 {
+			return 0, false
+		}
+		month, err := strconv.Atoi(c.segmentValue(f, m))
+		if err != nil || month < 1 || month > 12 {
+			return 0, false
+		}
+		return m, n >= internal.DaysIn(year, month)
+	case internal.KindHour:
+		d := f.calendarSegment(internal.KindDay, internal.KindDayOfYear)
+		return d, d >= 0 && n >= 23
+	case internal.KindMinute:
+		h := f.calendarSegment(internal.KindHour)
+		return h, h >= 0 && n >= 59
+	}
+	return 0, false
+}
+
+// resetValue returns the value the convention starts at. A modifier that is
+// not numeric, or a custom modifier without an initial value, has no start and
+// is returned unchanged.
+func resetValue(con *internal.Convention, value string) string {
+	if con.Kind == internal.KindCustom {
+		if con.Initial == "" && con.Level == internal.KeyModifier {
+			return value
+		}
+		return con.Initial
+	}
+	if value == "" {
+		return ""
+	}
+	switch {
+	case con.Kind.IsCalendar():
+		return formatCalendarValue(con, calendarStart(con))
+	case con.Kind == internal.KindModifier:
+		// Clearing a required modifier would leave its separator dangling.
+		if _, err := internal.IncWithPadding(value); err != nil {
+			return value
+		}
+	}
+	return internal.ResetWithPadding(value, 0)
+}
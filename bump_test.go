@@ -0,0 +1,362 @@
+package calver_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/shazib-summar/go-calver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionIncLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		version string
+		level   string
+		opts    calver.BumpOptions
+		want    string
+		wantErr bool
+	}{
+		{name: "1", format: "<YYYY>.<MINOR>.<MICRO>", version: "2025.3.7", level: "major", want: "2026.3.7"},
+		{
+			name:    "2",
+			format:  "<YYYY>.<MINOR>.<MICRO>",
+			version: "2025.3.7",
+			level:   "major",
+			opts:    calver.BumpOptions{ResetLower: true},
+			want:    "2026.0.0",
+		},
+		{
+			name:    "3",
+			format:  "<YYYY>.<0M>.<0D>",
+			version: "2025.07.14",
+			level:   "major",
+			opts:    calver.BumpOptions{ResetLower: true},
+			want:    "2026.01.01",
+		},
+		{
+			name:    "4",
+			format:  "<YY>.<MM>.<DD>",
+			version: "25.7.14",
+			level:   "Major",
+			opts:    calver.BumpOptions{ResetLower: true},
+			want:    "26.1.1",
+		},
+		{
+			name:    "5",
+			format:  "<YYYY>.<MINOR>.<MICRO>-<MODIFIER>",
+			version: "2025.3.7-rc1",
+			level:   "minor",
+			opts:    calver.BumpOptions{ResetLower: true},
+			want:    "2025.4.0-rc1",
+		},
+		{
+			name:    "6",
+			format:  "<YYYY>.<MINOR>.<MICRO>-<MODIFIER>",
+			version: "2025.3.07-004",
+			level:   "minor",
+			opts:    calver.BumpOptions{ResetLower: true},
+			want:    "2025.4.00-000",
+		},
+		{
+			name:    "7",
+			format:  "<YYYY>-W<0W>",
+			version: "2025-W30",
+			level:   "major",
+			opts:    calver.BumpOptions{ResetLower: true},
+			want:    "2026-W01",
+		},
+		{
+			name:    "8",
+			format:  "<YYYY>.<MINOR>.<MICRO>",
+			version: "2025.3.7",
+			level:   "micro",
+			opts:    calver.BumpOptions{ResetLower: true},
+			want:    "2025.3.8",
+		},
+		{name: "9", format: "<YYYY>.<MINOR>", version: "2025.3", level: "micro", want: "2025.3"},
+		{name: "10", format: "<YYYY>.<MINOR>", version: "2025.3", level: "foo", wantErr: true},
+		{name: "11", format: "<YYYY>-<MODIFIER>", version: "2025-rc", level: "modifier", wantErr: true},
+		{
+			name:    "12",
+			format:  "<YYYY>.<MINOR>.<MICRO>[-<MODIFIER>]",
+			version: "2025.3.7-rc1",
+			level:   "minor",
+			opts:    calver.BumpOptions{ResetLower: true},
+			want:    "2025.4.0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ver, err := calver.Parse(test.format, test.version)
+			assert.NoError(t, err)
+			err = ver.IncLevel(test.level, test.opts)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, ver.String())
+		})
+	}
+}
+
+func TestVersionIncRollover(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		version string
+		level   string
+		opts    calver.BumpOptions
+		want    string
+	}{
+		{name: "1", format: "<YYYY>.<0M>", version: "2025.12", level: "minor", want: "2026.01"},
+		{name: "2", format: "<YYYY>.<MM>", version: "2025.12", level: "minor", want: "2026.1"},
+		{name: "3", format: "<YYYY>.<0M>", version: "2025.11", level: "minor", want: "2025.12"},
+		{name: "4", format: "<YYYY>.<0M>", version: "2025.12", level: "minor", opts: calver.BumpOptions{Numeric: true}, want: "2025.13"},
+		{name: "5", format: "<YYYY>.<0M>.<0D>", version: "2025.07.31", level: "micro", want: "2025.08.01"},
+		{name: "6", format: "<YYYY>.<0M>.<0D>", version: "2025.12.31", level: "micro", want: "2026.01.01"},
+		{name: "7", format: "<YYYY>.<0M>.<0D>", version: "2025.02.28", level: "micro", want: "2025.03.01"},
+		{name: "8", format: "<YYYY>.<0M>.<0D>", version: "2024.02.28", level: "micro", want: "2024.02.29"},
+		{name: "9", format: "<YYYY>.<0M>.<0D>", version: "2024.02.29", level: "micro", want: "2024.03.01"},
+		{name: "10", format: "<YYYY>.<0M>.<0D>", version: "2025.04.30", level: "micro", want: "2025.05.01"},
+		{name: "11", format: "<YYYY>.<0M>.<0D>", version: "2025.07.31", level: "micro", opts: calver.BumpOptions{Numeric: true}, want: "2025.07.32"},
+		{name: "12", format: "<YYYY>-R<DD>", version: "2025-R31", level: "micro", want: "2025-R32"},
+		{name: "13", format: "<YYYY>-W<0W>", version: "2025-W52", level: "micro", want: "2026-W01"},
+		{name: "14", format: "<YYYY>-W<0W>", version: "2026-W52", level: "micro", want: "2026-W53"},
+		{name: "15", format: "<YYYY>-W<0W>", version: "2026-W53", level: "micro", want: "2027-W01"},
+		{name: "16", format: "<MAJOR>-W<0W>", version: "3-W53", level: "micro", want: "3-W54"},
+		{name: "17", format: "<YYYY>.<0M>.<0D>", version: "2025.12.14", level: "minor", want: "2026.01.14"},
+		{name: "18", format: "<0M>.<0D>", version: "02.29", level: "micro", want: "03.01"},
+		{name: "19", format: "<0M>", version: "12", level: "minor", want: "13"},
+		{
+			name:    "20",
+			format:  "<YYYY>.<0M>.<MICRO>",
+			version: "2025.12.7",
+			level:   "minor",
+			opts:    calver.BumpOptions{ResetLower: true},
+			want:    "2026.01.0",
+		},
+		{name: "21", format: "<YYYY>Q<Q>", version: "2025Q3", level: "minor", want: "2025Q4"},
+		{name: "22", format: "<YYYY>Q<Q>", version: "2025Q4", level: "minor", want: "2026Q1"},
+		{name: "23", format: "<YYYY>.<0DOY>", version: "2025.365", level: "minor", want: "2026.001"},
+		{name: "24", format: "<YYYY>.<0DOY>", version: "2024.365", level: "minor", want: "2024.366"},
+		{name: "25", format: "<YYYY>.<0DOY>.<0H>", version: "2025.365.23", level: "micro", want: "2026.001.00"},
+		{name: "26", format: "<YYYY>.<0M>.<0H>", version: "2025.07.23", level: "micro", want: "2025.07.24"},
+		{name: "27", format: "<YYYY>.<0DOY>.<0H>.<mm>", version: "2025.195.09.59", level: "micro", want: "2025.195.10.59"},
+		{name: "28", format: "<YYYY>.<0M>.<0H>.<mm>", version: "2025.07.09.59", level: "modifier", want: "2025.07.09.59"},
+		{
+			name:    "29",
+			format:  "<YYYY>.<0DOY>.<0H>.<mm>",
+			version: "2025.195.09.42",
+			level:   "minor",
+			opts:    calver.BumpOptions{ResetLower: true},
+			want:    "2025.196.00.00",
+		},
+		{name: "30", format: "<YYYY>.<0M>.<0D>.<0H>", version: "2025.07.31.23", level: "micro", want: "2025.08.01.23"},
+		{
+			name:    "31",
+			format:  "<YYYY>.<0M>.<0D>.<MICRO>",
+			version: "2025.07.31.4",
+			level:   "micro",
+			opts:    calver.BumpOptions{ResetLower: true},
+			want:    "2025.08.01.0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ver, err := calver.Parse(test.format, test.version)
+			assert.NoError(t, err)
+			err = ver.IncLevel(test.level, test.opts)
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, ver.String())
+		})
+	}
+}
+
+func TestVersionIncClamp(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		version string
+		level   string
+		opts    calver.BumpOptions
+		want    string
+	}{
+		{name: "1", format: "<YYYY>.<0M>.<0D>", version: "2025.01.31", level: "minor", want: "2025.02.28"},
+		{name: "2", format: "<YYYY>.<0M>.<0D>", version: "2024.01.30", level: "minor", want: "2024.02.29"},
+		{name: "3", format: "<YYYY>.<0M>.<0D>", version: "2024.02.29", level: "major", want: "2025.02.28"},
+		{name: "4", format: "<YYYY>.<MM>.<DD>", version: "2025.3.31", level: "minor", want: "2025.4.30"},
+		{name: "5", format: "<YYYY>-W<0W>", version: "2026-W53", level: "major", want: "2027-W52"},
+		{name: "6", format: "<0Y>.<WW>", version: "26.53", level: "major", want: "27.52"},
+		{name: "7", format: "<YYYY>.<0DOY>", version: "2024.366", level: "major", want: "2025.365"},
+		{name: "8", format: "<YYYY>.<0M>.<0D>", version: "2025.12.31", level: "minor", want: "2026.01.31"},
+		{name: "9", format: "<YYYY>.<0M>.<0D>", version: "2025.01.31", level: "minor", opts: calver.BumpOptions{Numeric: true}, want: "2025.02.31"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ver, err := calver.Parse(test.format, test.version)
+			assert.NoError(t, err)
+			assert.NoError(t, ver.IncLevel(test.level, test.opts))
+			assert.Equal(t, test.want, ver.String())
+			if test.opts.Numeric {
+				return
+			}
+			_, err = calver.ParseWithOptions(ver.String(), calver.WithFormat(test.format), calver.WithStrictCalendar())
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestVersionIncMajorMinorClamp(t *testing.T) {
+	ver, err := calver.Parse("<YYYY>-W<0W>", "2026-W53")
+	assert.NoError(t, err)
+	assert.NoError(t, ver.IncMajor())
+	assert.Equal(t, "2027-W52", ver.String())
+
+	ver, err = calver.Parse("<YYYY>.<0M>.<0D>", "2025.01.31")
+	assert.NoError(t, err)
+	assert.NoError(t, ver.IncMinor())
+	assert.Equal(t, "2025.02.28", ver.String())
+}
+
+func TestVersionIncMinorMicroRollover(t *testing.T) {
+	ver, err := calver.Parse("<YYYY>.<0M>.<0D>", "2025.12.31")
+	assert.NoError(t, err)
+	assert.NoError(t, ver.IncMicro())
+	assert.Equal(t, "2026.01.01", ver.String())
+	assert.NoError(t, ver.IncMinor())
+	assert.Equal(t, "2026.02.01", ver.String())
+}
+
+func TestVersionIncSegment(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		version string
+		segment int
+		opts    calver.BumpOptions
+		want    string
+		wantErr bool
+	}{
+		{name: "1", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.07.14.3", segment: 3, want: "2025.07.14.4"},
+		{name: "2", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.07.14.09", segment: 3, want: "2025.07.14.10"},
+		{name: "3", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.07.14.3", segment: 2, want: "2025.07.15.3"},
+		{
+			name:    "4",
+			format:  "<YYYY>.<0M>.<0D>.<MICRO>",
+			version: "2025.07.14.3",
+			segment: 1,
+			opts:    calver.BumpOptions{ResetLower: true},
+			want:    "2025.08.01.0",
+		},
+		{name: "5", format: "<YYYY>.<0M>.<0D>.<0H><mm>", version: "2025.12.31.2359", segment: 4, want: "2026.01.01.0000"},
+		{name: "6", format: "<YYYY>.<0M>.<0D>.<0H><mm>", version: "2025.07.14.2359", segment: 4, opts: calver.BumpOptions{Numeric: true}, want: "2025.07.14.2360"},
+		{name: "7", format: "<YYYY>.<MAJOR>", version: "2025.7", segment: 1, want: "2025.8"},
+		{name: "8", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.07.14.3", segment: 4, wantErr: true},
+		{name: "9", format: "<YYYY>.<0M>.<0D>-<MODIFIER>", version: "2025.07.14-rc", segment: 3, wantErr: true},
+		{name: "10", format: "<YYYY>.<0M>.<0D>[.<MICRO>]", version: "2025.07.14", segment: 3, want: "2025.07.14.1"},
+		{name: "11", format: "<YYYY>.<0M>.<0D>[.<MICRO>]", version: "2025.07.14.1", segment: 3, want: "2025.07.14.2"},
+		{
+			name:    "12",
+			format:  "<YYYY>.<0M>.<0D>[.<MICRO>]",
+			version: "2025.07.14.2",
+			segment: 2,
+			opts:    calver.BumpOptions{ResetLower: true},
+			want:    "2025.07.15",
+		},
+		{name: "13", format: "<YYYY>.<0DOY>.<0H>.<mm>", version: "2025.195.09.59", segment: 3, want: "2025.195.10.00"},
+		{name: "14", format: "<YYYY>.<0DOY>.<0H>.<mm>", version: "2025.365.23.59", segment: 3, want: "2026.001.00.00"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ver, err := calver.Parse(test.format, test.version)
+			assert.NoError(t, err)
+			err = ver.IncSegment(test.segment, test.opts)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, ver.String())
+		})
+	}
+}
+
+func TestVersionBump(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		version string
+		level   string
+		opts    calver.BumpOptions
+		want    string
+		wantErr bool
+	}{
+		{name: "1", format: "<YYYY>.<MINOR>.<MICRO>", version: "2025.3.7", level: "major", want: "2026.3.7"},
+		{name: "2", format: "<YYYY>.<MINOR>.<MICRO>", version: "2025.3.7", level: "minor", opts: calver.BumpOptions{ResetLower: true}, want: "2025.4.0"},
+		{name: "3", format: "<YYYY>.<0M>.<0D>", version: "2025.12.31", level: "micro", want: "2026.01.01"},
+		{name: "4", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.07.14.3", level: "major", opts: calver.BumpOptions{ResetLower: true}, want: "2026.01.01.0"},
+		{name: "5", format: "<YYYY>.<0M>-<MODIFIER>", version: "2025.07-rc", level: "modifier", wantErr: true},
+		{name: "6", format: "<YYYY>.<0M>", version: "2025.07", level: "foo", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ver, err := calver.Parse(test.format, test.version)
+			assert.NoError(t, err)
+			bumped, err := ver.Bump(test.level, test.opts)
+			assert.Equal(t, test.version, ver.String())
+			if test.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, bumped)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, bumped.String())
+			assert.Equal(t, test.format, bumped.Format)
+		})
+	}
+}
+
+func TestVersionBumpSegment(t *testing.T) {
+	ver := calver.MustCompileFormat("<YYYY>.<0M>.<0D>.<MICRO>").MustParse("2025.07.14.3")
+
+	bumped, err := ver.BumpSegment(3, calver.BumpOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "2025.07.14.4", bumped.String())
+	assert.Equal(t, "4", bumped.Segments()[3].Value)
+
+	bumped, err = ver.BumpSegment(1, calver.BumpOptions{ResetLower: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "2025.08.01.0", bumped.String())
+
+	_, err = ver.BumpSegment(4, calver.BumpOptions{})
+	assert.Error(t, err)
+	assert.Equal(t, "2025.07.14.3", ver.String())
+	assert.Equal(t, "3", ver.Segments()[3].Value)
+}
+
+func TestVersionBumpConcurrent(t *testing.T) {
+	ver := calver.MustCompileFormat("<YYYY>.<0M>.<0D>.<MICRO>").MustParse("2025.07.14.3")
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				bumped, err := ver.BumpSegment(3, calver.BumpOptions{})
+				assert.NoError(t, err)
+				assert.Equal(t, "2025.07.14.4", bumped.String())
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, "2025.07.14.3", ver.String())
+}
@@ -0,0 +1,309 @@
+package calver
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/shazib-summar/go-calver/internal"
+)
+
+// WithStrictCalendar is a parse option that validates the calendar values of
+// the version string in addition to their number of digits. When it is used,
+// months must be between 1 and 12, days must exist in the month, taking leap
+// years into account, and ISO weeks must be between 1 and 52 or 53 depending
+// on the year. If the format has no year, February is allowed to have 29 days
+// and years are allowed to have 53 weeks.
+//
+// Example:
+//
+//	_, err := calver.ParseWithOptions(
+//	    "2025-02-30",
+//	    calver.WithFormat("<YYYY>-<0M>-<0D>"),
+//	    calver.WithStrictCalendar(),
+//	)
+//	fmt.Println(errors.Is(err, calver.ErrInvalidCalendar)) // true
+//
+// If multiple formats are provided, formats that match the version string but
+// whose calendar values are invalid are skipped.
+func WithStrictCalendar() parseOption {
+	return func(options *parseOptions) {
+		options.strictCalendar = true
+	}
+}
+
+// validateCalendar returns a *CalendarError if a calendar value captured by the
+// format is not a valid date. The values are those of the segments.
+func (f *Format) validateCalendar(version string, values []string) error {
+	newErr := func(i int, reason string) error {
+		return &CalendarError{
+			Version:    version,
+			Format:     f.raw,
+			Convention: f.segments[i].convention.Name,
+			Value:      values[i],
+			Reason:     reason,
+		}
+	}
+
+	year := 0
+	if i := f.calendarSegment(internal.KindYear, internal.KindShortYear); i >= 0 {
+		year, _ = internal.Year(f.segments[i].convention.Kind, values[i])
+	}
+	month := 0
+	if i := f.calendarSegment(internal.KindMonth); i >= 0 && !f.absent(i, values) {
+		month, _ = strconv.Atoi(values[i])
+		if month < 1 || month > 12 {
+			return newErr(i, "month must be between 1 and 12")
+		}
+	}
+
+	for i, seg := range f.segments {
+		if f.absent(i, values) {
+			continue
+		}
+		value, _ := strconv.Atoi(values[i])
+		switch seg.convention.Kind {
+		case internal.KindQuarter:
+			if value < 1 || value > 4 {
+				return newErr(i, "quarter must be between 1 and 4")
+			}
+		case internal.KindDayOfYear:
+			days := internal.DaysInYear(year)
+			if value < 1 || value > days {
+				return newErr(i, fmt.Sprintf("day of year must be between 1 and %d", days))
+			}
+		case internal.KindDay:
+			days := 31
+			if month != 0 {
+				days = internal.DaysIn(year, month)
+			}
+			if value < 1 || value > days {
+				return newErr(i, fmt.Sprintf("day must be between 1 and %d", days))
+			}
+		case internal.KindWeek:
+			weeks := internal.ISOWeeksIn(year)
+			if month != 0 {
+				// The first days of January may be in the last week of
+				// the previous ISO year.
+				weeks = max(weeks, internal.ISOWeeksIn(year-1))
+			}
+			if value < 1 || value > weeks {
+				return newErr(i, fmt.Sprintf("week must be between 1 and %d", weeks))
+			}
+		case internal.KindHour:
+			if value < 0 || value > 23 {
+				return newErr(i, "hour must be between 0 and 23")
+			}
+		case internal.KindMinute:
+			if value < 0 || value > 59 {
+				return newErr(i, "minute must be between 0 and 59")
+			}
+		}
+	}
+	return nil
+}
+
+// Time returns the date represented by the calendar levels of the version in
+// UTC. The year is taken from <YYYY>, <YY> or <0Y>, the month from <MM> or
+// <0M>, and the day from <DD> or <0D>. A week, <WW> or <0W>, resolves to the
+// Monday starting the ISO week, a quarter, <Q>, to its first month and a day
+// of the year, <DOY> or <0DOY>, to its date. The hour, <HH> or <0H>, and the
+// minute, <mm>, set the time of day. Missing calendar levels default to the
+// start of the period, so "2025.07" with the format "<YYYY>.<0M>" is July 1st
+// 2025 at midnight.
+//
+// If the format locates the date in several ways, the day of the year takes
+// precedence over the week, the week over the month and day, and the month
+// over the quarter, so "2025.07.29" with the format "<YYYY>.<0M>.<0W>" is the
+// Monday of week 29. A week combined with a month is the ISO week with a day in
+// that month, so "2024.12.01" is 2024-12-30, the Monday of week 1 of 2025.
+//
+// It returns an error wrapping ErrNoYear if the format has no year convention
+// and a *CalendarError if a calendar value is not a valid date.
+//
+// Example:
+//
+//	ver, err := calver.Parse("<YYYY>.<0M>.<0D>", "2025.07.14")
+//	if err != nil {
+//	    return err
+//	}
+//	t, err := ver.Time()
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(t.Format(time.DateOnly)) // 2025-07-14
+func (c *Version) Time() (time.Time, error) {
+	f, err := c.compiledFormat()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	values := c.segmentValues(f)
+	year := 0
+	if i := f.calendarSegment(internal.KindYear, internal.KindShortYear); i >= 0 {
+		year, _ = internal.Year(f.segments[i].convention.Kind, values[i])
+	}
+	if year == 0 {
+		return time.Time{}, fmt.Errorf("version %q: %w", c.String(), ErrNoYear)
+	}
+	if err := f.validateCalendar(c.String(), values); err != nil {
+		return time.Time{}, err
+	}
+
+	quarter, month, day, dayOfYear, week, hour, minute := 0, 0, 1, 0, 0, 0, 0
+	for i, seg := range f.segments {
+		if f.absent(i, values) {
+			continue
+		}
+		value, _ := strconv.Atoi(values[i])
+		switch seg.convention.Kind {
+		case internal.KindQuarter:
+			quarter = value
+		case internal.KindMonth:
+			month = value
+		case internal.KindDay:
+			day = value
+		case internal.KindDayOfYear:
+			dayOfYear = value
+		case internal.KindWeek:
+			week = value
+		case internal.KindHour:
+			hour = value
+		case internal.KindMinute:
+			minute = value
+		}
+	}
+
+	if month == 0 {
+		month = 1
+		if quarter != 0 {
+			month = 3*(quarter-1) + 1
+		}
+	}
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	switch {
+	case dayOfYear != 0:
+		// time.Date normalizes January 214th to August 2nd.
+		date = time.Date(year, time.January, dayOfYear, 0, 0, 0, 0, time.UTC)
+	case week != 0:
+		if i := f.calendarSegment(internal.KindMonth); i >= 0 && !f.absent(i, values) {
+			date = weekInMonth(year, time.Month(month), week)
+		} else {
+			date = internal.ISOWeekStart(year, week)
+		}
+	}
+	return date.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute), nil
+}
+
+// FromTime returns a Version of the format for the given instant. Calendar
+// conventions are set from the date of t, counters such as <MINOR> are set to
+// 0 and the modifier is left empty. Conventions in optional sections other
+// than calendar conventions are left empty. If the week is the only part of
+// the year in the format, e.g. <YYYY>-W<0W>, the year is the ISO year of t,
+// which may differ from its calendar year around New Year. If the format also
+// has a quarter, month or day, the year is the calendar year, so 2024-12-30 is
+// 2024.12.01 with the format <YYYY>.<0M>.<0W>.
+//
+// It returns a *CalendarError if the format uses a short year, i.e. <YY> or
+// <0Y>, and the year of t is not between 2000 and 2099.
+//
+// Example:
+//
+//	f := calver.MustCompileFormat("<YYYY>.<0M>.<MICRO>")
+//	ver, err := f.FromTime(time.Date(2025, time.July, 14, 0, 0, 0, 0, time.UTC))
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(ver.String()) // 2025.07.0
+func (f *Format) FromTime(t time.Time) (*Version, error) {
+	year, month, day := t.Date()
+	isoYear, week := t.ISOWeek()
+	if f.calendarSegment(internal.KindWeek) >= 0 &&
+		f.calendarSegment(internal.KindQuarter, internal.KindMonth, internal.KindDayOfYear, internal.KindDay) < 0 {
+		year = isoYear
+	}
+
+	ver := &Version{Format: f.raw, format: f}
+	for i, seg := range f.segments {
+		con := seg.convention
+		var value string
+		switch con.Kind {
+		case internal.KindYear:
+			value = fmt.Sprintf("%04d", year)
+		case internal.KindShortYear:
+			if year < 2000 || year >= 2100 {
+				return nil, &CalendarError{
+					Version:    t.Format(time.DateOnly),
+					Format:     f.raw,
+					Convention: con.Name,
+					Value:      strconv.Itoa(year),
+					Reason:     "short years only represent the years 2000 to 2099",
+				}
+			}
+			value = formatCalendarValue(con, year-2000)
+		case internal.KindMonth:
+			value = formatCalendarValue(con, int(month))
+		case internal.KindDay:
+			value = formatCalendarValue(con, day)
+		case internal.KindWeek:
+			value = formatCalendarValue(con, week)
+		case internal.KindQuarter:
+			value = formatCalendarValue(con, (int(month)-1)/3+1)
+		case internal.KindDayOfYear:
+			value = formatCalendarValue(con, t.YearDay())
+		case internal.KindHour:
+			value = formatCalendarValue(con, t.Hour())
+		case internal.KindMinute:
+			value = formatCalendarValue(con, t.Minute())
+		case internal.KindCounter:
+			value = "0"
+		case internal.KindCustom:
+			value = con.Initial
+		}
+		if seg.optional && !con.Kind.IsCalendar() {
+			value = ""
+		}
+		ver.setSegmentValue(f, i, value)
+	}
+	return ver, nil
+}
+
+// weekInMonth returns the Monday starting the ISO week with the given number
+// that has a day in the month of the calendar year. The week may belong to the
+// ISO year before or after the calendar year, e.g. week 1 in December 2024
+// starts on 2024-12-30. If no such week exists, the week of the ISO year equal
+// to the calendar year is returned.
+func weekInMonth(year int, month time.Month, week int) time.Time {
+	for _, isoYear := range []int{year, year + 1, year - 1} {
+		start := internal.ISOWeekStart(isoYear, week)
+		for d := range 7 {
+			day := start.AddDate(0, 0, d)
+			if day.Year() == year && day.Month() == month {
+				return start
+			}
+		}
+	}
+	return internal.ISOWeekStart(year, week)
+}
+
+// formatCalendarValue formats n as the value of the convention, padding it
+// with zeros if the convention is padded: to three digits for days of the year
+// and to two digits otherwise.
+func formatCalendarValue(con *internal.Convention, n int) string {
+	switch {
+	case !con.Padded:
+		return strconv.Itoa(n)
+	case con.Kind == internal.KindDayOfYear:
+		return fmt.Sprintf("%03d", n)
+	}
+	return fmt.Sprintf("%02d", n)
+}
+
+// calendarStart returns the first value of a calendar convention, which is 0
+// for hours and minutes and 1 otherwise.
+func calendarStart(con *internal.Convention) int {
+	if con.Kind == internal.KindHour || con.Kind == internal.KindMinute {
+		return 0
+	}
+	return 1
+}
@@ -0,0 +1,232 @@
+package calver_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shazib-summar/go-calver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithStrictCalendar(t *testing.T) {
+	tests := []struct {
+		name           string
+		format         string
+		version        string
+		wantConvention string
+	}{
+		{name: "1", format: "<YYYY>-<0M>-<0D>", version: "2025-07-14"},
+		{name: "2", format: "<YYYY>-<0M>-<0D>", version: "2025-02-28"},
+		{name: "3", format: "<YYYY>-<0M>-<0D>", version: "2024-02-29"},
+		{name: "4", format: "<YYYY>-<0M>-<0D>", version: "2025-02-29", wantConvention: "<0D>"},
+		{name: "5", format: "<YYYY>-<0M>-<0D>", version: "2025-02-30", wantConvention: "<0D>"},
+		{name: "6", format: "<YYYY>-<0M>-<0D>", version: "2025-04-31", wantConvention: "<0D>"},
+		{name: "7", format: "<YYYY>-<0M>-<0D>", version: "2025-07-00", wantConvention: "<0D>"},
+		{name: "8", format: "<YYYY>.<MM>", version: "2025.99", wantConvention: "<MM>"},
+		{name: "9", format: "<YYYY>.<0M>", version: "2025.00", wantConvention: "<0M>"},
+		{name: "10", format: "<YYYY>.<0M>", version: "2025.12"},
+		{name: "11", format: "<YYYY>.<DD>", version: "2025.45", wantConvention: "<DD>"},
+		{name: "12", format: "<YYYY>.<DD>", version: "2025.31"},
+		{name: "13", format: "<0M>.<DD>", version: "02.29"},
+		{name: "14", format: "<0M>.<DD>", version: "02.30", wantConvention: "<DD>"},
+		{name: "15", format: "<YYYY>-W<WW>", version: "2025-W77", wantConvention: "<WW>"},
+		{name: "16", format: "<YYYY>-W<WW>", version: "2025-W53", wantConvention: "<WW>"},
+		{name: "17", format: "<YYYY>-W<WW>", version: "2026-W53"},
+		{name: "18", format: "<YYYY>-W<0W>", version: "2025-W00", wantConvention: "<0W>"},
+		{name: "19", format: "<0Y>.<0M>.<0D>", version: "24.02.29"},
+		{name: "20", format: "<0Y>.<0M>.<0D>", version: "23.02.29", wantConvention: "<0D>"},
+		{name: "21", format: "<MAJOR>.<MINOR>.<MICRO>", version: "2025.99.99"},
+		{name: "22", format: "<YYYY>Q<Q>", version: "2025Q4"},
+		{name: "23", format: "<YYYY>Q<Q>", version: "2025Q5", wantConvention: "<Q>"},
+		{name: "24", format: "<YYYY>Q<Q>", version: "2025Q0", wantConvention: "<Q>"},
+		{name: "25", format: "<YYYY>.<0DOY>", version: "2024.366"},
+		{name: "26", format: "<YYYY>.<0DOY>", version: "2025.366", wantConvention: "<0DOY>"},
+		{name: "27", format: "<YYYY>.<DOY>", version: "2025.0", wantConvention: "<DOY>"},
+		{name: "28", format: "<YYYY>.<0DOY>.<0H>", version: "2025.001.23"},
+		{name: "29", format: "<YYYY>.<0DOY>.<0H>", version: "2025.001.24", wantConvention: "<0H>"},
+		{name: "30", format: "<YYYY>.<0M>.<HH><mm>", version: "2025.07.1059"},
+		{name: "31", format: "<YYYY>.<0M>.<HH><mm>", version: "2025.07.1060", wantConvention: "<mm>"},
+		{name: "32", format: "<YYYY>.<0M>.<0D>.<0H><mm>", version: "2025.02.28.2359"},
+		{name: "33", format: "<YYYY>.<0M>.<0D>.<0H><mm>", version: "2025.02.29.2359", wantConvention: "<0D>"},
+		{name: "34", format: "<YYYY>.<0M>.<0D>.<0H><mm>", version: "2025.02.28.2459", wantConvention: "<0H>"},
+		{name: "35", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.02.28.99"},
+		{name: "36", format: "<YYYY>.<0M>[.<0D>]", version: "2025.02"},
+		{name: "37", format: "<YYYY>.<0M>[.<0D>]", version: "2025.02.29", wantConvention: "<0D>"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := calver.Parse(test.format, test.version)
+			assert.NoError(t, err)
+
+			_, err = calver.ParseWithOptions(
+				test.version,
+				calver.WithFormat(test.format),
+				calver.WithStrictCalendar(),
+			)
+			if test.wantConvention == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, calver.ErrInvalidCalendar)
+			var ce *calver.CalendarError
+			assert.True(t, errors.As(err, &ce))
+			assert.Equal(t, test.version, ce.Version)
+			assert.Equal(t, test.format, ce.Format)
+			assert.Equal(t, test.wantConvention, ce.Convention)
+		})
+	}
+}
+
+func TestWithStrictCalendarMultipleFormats(t *testing.T) {
+	ver, err := calver.ParseWithOptions(
+		"2025.13.01",
+		calver.WithFormat("<YYYY>.<0M>.<0D>", "<YYYY>.<MINOR>.<MICRO>"),
+		calver.WithStrictCalendar(),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "<YYYY>.<MINOR>.<MICRO>", ver.Format)
+
+	_, err = calver.NewCollectionWithOptions(
+		[]string{"2025.07.14", "2025.13.01"},
+		calver.WithFormat("<YYYY>.<0M>.<0D>"),
+		calver.WithStrictCalendar(),
+	)
+	assert.ErrorIs(t, err, calver.ErrInvalidCalendar)
+}
+
+func TestVersionTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		version string
+		want    string
+		wantErr error
+	}{
+		{name: "1", format: "<YYYY>.<0M>.<0D>", version: "2025.07.14", want: "2025-07-14"},
+		{name: "2", format: "<YY>.<MM>.<DD>", version: "25.7.4", want: "2025-07-04"},
+		{name: "3", format: "<0Y>.<0M>", version: "05.03", want: "2005-03-01"},
+		{name: "4", format: "<YYYY>", version: "2025", want: "2025-01-01"},
+		{name: "5", format: "<YYYY>-W<0W>", version: "2025-W29", want: "2025-07-14"},
+		{name: "6", format: "<YYYY>-W<WW>", version: "2025-W1", want: "2024-12-30"},
+		{name: "7", format: "<YYYY>.<0M>.<MICRO>", version: "2025.07.3", want: "2025-07-01"},
+		{name: "8", format: "<YYYY>.<MINOR>", version: "2025.7", want: "2025-01-01"},
+		{name: "9", format: "<MAJOR>.<0M>.<0D>", version: "2025.07.14", wantErr: calver.ErrNoYear},
+		{name: "10", format: "<YYYY>.<0M>.<0D>", version: "2025.02.30", wantErr: calver.ErrInvalidCalendar},
+		{name: "11", format: "<YYYY>Q<Q>", version: "2025Q3", want: "2025-07-01"},
+		{name: "12", format: "<YYYY>.<0DOY>", version: "2025.032", want: "2025-02-01"},
+		{name: "13", format: "<YYYY>.<DOY>", version: "2024.366", want: "2024-12-31"},
+		{name: "14", format: "<YYYY>.<DOY>", version: "2025.366", wantErr: calver.ErrInvalidCalendar},
+		{name: "15", format: "<YYYY>[-W<0W>]", version: "2025", want: "2025-01-01"},
+		{name: "16", format: "<YYYY>.<0M>[.<0D>]", version: "2025.07", want: "2025-07-01"},
+		{name: "17", format: "<YYYY>.<0M>[.<0D>]", version: "2025.07.14", want: "2025-07-14"},
+		{name: "18", format: "<YYYY>.<0M>.<0W>", version: "2025.07.29", want: "2025-07-14"},
+		{name: "19", format: "<YYYY>.<DOY>.<DD>", version: "2025.195.20", want: "2025-07-14"},
+		{name: "20", format: "<YYYY>Q<Q>.<0M>", version: "2025Q3.08", want: "2025-08-01"},
+		{name: "21", format: "<YYYY>.<0M>.<0D>.<0DOY>", version: "2025.01.01.032", want: "2025-02-01"},
+		{name: "22", format: "<YYYY>.<0M>.<0W>", version: "2024.12.01", want: "2024-12-30"},
+		{name: "23", format: "<YYYY>.<0M>.<0W>", version: "2021.01.53", want: "2020-12-28"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ver, err := calver.Parse(test.format, test.version)
+			assert.NoError(t, err)
+			got, err := ver.Time()
+			if test.wantErr != nil {
+				assert.ErrorIs(t, err, test.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got.Format(time.DateOnly))
+			assert.Equal(t, time.UTC, got.Location())
+		})
+	}
+}
+
+func TestVersionTimeOfDay(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		version string
+		want    string
+	}{
+		{name: "1", format: "<YYYY>.<0DOY>.<0H>", version: "2025.195.09", want: "2025-07-14 09:00:00"},
+		{name: "2", format: "<YYYY>.<0M>.<HH>.<mm>", version: "2025.07.18.05", want: "2025-07-01 18:05:00"},
+		{name: "3", format: "<YYYY>.<0M>.<0D>", version: "2025.07.14", want: "2025-07-14 00:00:00"},
+		{name: "4", format: "<YYYY>.<0M>.<0D>.<0H><mm>", version: "2025.07.14.1830", want: "2025-07-14 18:30:00"},
+		{name: "5", format: "<YYYY>-W<0W>.<0H>", version: "2025-W29.06", want: "2025-07-14 06:00:00"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ver, err := calver.Parse(test.format, test.version)
+			assert.NoError(t, err)
+			got, err := ver.Time()
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got.Format(time.DateTime))
+		})
+	}
+}
+
+func TestFormatFromTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		time    time.Time
+		want    string
+		wantErr bool
+	}{
+		{name: "1", format: "<YYYY>.<0M>.<0D>", time: date(2025, 7, 4), want: "2025.07.04"},
+		{name: "2", format: "<YY>.<MM>.<DD>", time: date(2025, 7, 4), want: "25.7.4"},
+		{name: "3", format: "<0Y>.<0M>", time: date(2005, 3, 9), want: "05.03"},
+		{name: "4", format: "<YYYY>.<0M>.<MICRO>", time: date(2025, 7, 14), want: "2025.07.0"},
+		{name: "5", format: "<YYYY>-W<0W>", time: date(2025, 7, 14), want: "2025-W29"},
+		{name: "6", format: "<YYYY>-W<0W>", time: date(2024, 12, 30), want: "2025-W01"},
+		{name: "7", format: "Rel-<YYYY>-<0M>-<0D>-<MODIFIER>", time: date(2025, 7, 14), want: "Rel-2025-07-14-"},
+		{name: "8", format: "<0Y>.<0M>", time: date(1999, 3, 9), wantErr: true},
+		{name: "9", format: "<YYYY>Q<Q>", time: date(2025, 7, 14), want: "2025Q3"},
+		{name: "10", format: "<YYYY>Q<Q>", time: date(2025, 12, 31), want: "2025Q4"},
+		{name: "11", format: "<YYYY>.<0DOY>", time: date(2025, 2, 1), want: "2025.032"},
+		{name: "12", format: "<YYYY>.<DOY>", time: date(2024, 12, 31), want: "2024.366"},
+		{name: "13", format: "<YYYY>.<0DOY>.<0H>", time: time.Date(2025, 7, 14, 9, 30, 0, 0, time.UTC), want: "2025.195.09"},
+		{name: "14", format: "<YYYY>.<0M>.<HH>.<mm>", time: time.Date(2025, 7, 14, 9, 5, 0, 0, time.UTC), want: "2025.07.9.05"},
+		{name: "15", format: "<YYYY>.<0M>.<0D>.<MICRO>", time: date(2025, 7, 14), want: "2025.07.14.0"},
+		{name: "16", format: "<YYYY>.<0M>.<0D>.<0H><mm>", time: time.Date(2025, 7, 14, 9, 5, 0, 0, time.UTC), want: "2025.07.14.0905"},
+		{name: "17", format: "<YYYY>.<0M>.<0D>[.<MICRO>]", time: date(2025, 7, 14), want: "2025.07.14"},
+		{name: "18", format: "<YYYY>.<0M>[.<0D>]", time: date(2025, 7, 14), want: "2025.07.14"},
+		{name: "19", format: "<YY>.<0M>", time: date(2100, 1, 1), wantErr: true},
+		{name: "20", format: "<0Y>.<0M>", time: date(2099, 12, 31), want: "99.12"},
+		{name: "21", format: "<YYYY>.<0M>.<0W>", time: date(2024, 12, 30), want: "2024.12.01"},
+		{name: "22", format: "<YYYY>.<0M>.<0W>", time: date(2021, 1, 1), want: "2021.01.53"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f := calver.MustCompileFormat(test.format)
+			ver, err := f.FromTime(test.time)
+			if test.wantErr {
+				assert.ErrorIs(t, err, calver.ErrInvalidCalendar)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, ver.String())
+			assert.Equal(t, test.format, ver.Format)
+		})
+	}
+}
+
+func TestFormatFromTimeRoundTrip(t *testing.T) {
+	f := calver.MustCompileFormat("<YYYY>.<0M>.<0D>")
+	want := date(2024, 2, 29)
+	ver, err := f.FromTime(want)
+	assert.NoError(t, err)
+	got, err := ver.Time()
+	assert.NoError(t, err)
+	assert.True(t, want.Equal(got))
+}
+
+func date(year, month, day int) time.Time {
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
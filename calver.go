@@ -2,8 +2,8 @@ package calver
 
 import (
 	"fmt"
-	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/shazib-summar/go-calver/internal"
@@ -11,6 +11,16 @@ import (
 
 // Version is the object representing a CalVer version. To get the string
 // representation of the Version, use the String method.
+//
+// The methods of a Version that do not modify it, such as String, Compare,
+// Bump and Clone, are safe for concurrent use. The Inc methods, IncLevel,
+// IncSegment and SetSegment modify the version in place and must not be called
+// on a version shared by several goroutines, e.g. held by a Collection. Use
+// Bump, BumpSegment or Clone instead.
+//
+// Versions are comparable, so they can be compared with == and used as map
+// keys. Two versions are equal if they have the same values and were parsed
+// with the same Format. Use Equal to compare versions by their levels.
 type Version struct {
 	// Format is the original format string. If multiple formats were provided,
 	// this will be the format that matched the version string.
@@ -23,10 +33,25 @@ type Version struct {
 	Micro string
 	// Modifier is the modifier version. This can be a number or a string.
 	Modifier string
+
+	// extra holds the values of the conventions that are not the first of
+	// their level, e.g. the build counter <MICRO> of <YYYY>.<0M>.<0D>.<MICRO>
+	// whose first micro convention is the day, see Segments. The values are
+	// encoded in a string, see appendExtra, so that Version stays comparable.
+	extra string
+	// format is the compiled Format that matched the version string.
+	format *Format
 }
 
 type parseOptions struct {
-	formats []string
+	formats        []string
+	compiled       []*Format
+	strictCalendar bool
+	registry       *Registry
+	resolution     Resolution
+	rejectPolicy   RejectPolicy
+	rejectLimit    int
+	workers        int
 }
 
 type parseOption func(*parseOptions)
@@ -80,6 +105,50 @@ func WithFormat(formats ...string) parseOption {
 	}
 }
 
+// WithCompiledFormat is a parse option that specifies already compiled formats
+// that should be used to parse the version string. It can be combined with
+// WithFormat in which case the formats provided to WithFormat are tried first.
+//
+// Example:
+//
+//	f := calver.MustCompileFormat("<YYYY>.<0M>.<0D>")
+//	ver, err := ParseWithOptions("2025.07.14", WithCompiledFormat(f))
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(ver.String()) // 2025.07.14
+func WithCompiledFormat(formats ...*Format) parseOption {
+	return func(options *parseOptions) {
+		options.compiled = formats
+	}
+}
+
+// newParseOptions applies the parse options and returns the formats that
+// should be used to parse version strings.
+func newParseOptions(opts []parseOption) (*parseOptions, []*Format, error) {
+	if len(opts) == 0 {
+		return nil, nil, fmt.Errorf("at least one parseOption is required: %w", ErrNoFormat)
+	}
+	o := &parseOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if len(o.formats) == 0 && len(o.compiled) == 0 {
+		return nil, nil, ErrNoFormat
+	}
+
+	compile := compileFormats
+	if o.registry != nil {
+		compile = o.registry.compileFormats
+	}
+	formats, err := compile(o.formats)
+	if err != nil {
+		return nil, nil, err
+	}
+	return o, append(formats, o.compiled...), nil
+}
+
 // Parse creates a new Version object from a format string and a version. The
 // format string is expected to follow the conventions defined in
 // ConventionsRegex.
@@ -126,77 +195,11 @@ func Parse(format string, version string) (*Version, error) {
 //	}
 //	fmt.Println(ver.String()) // Rel-2025-07-14
 func ParseWithOptions(version string, opts ...parseOption) (*Version, error) {
-	if len(opts) == 0 {
-		return nil, fmt.Errorf("at least one parseOption is required")
-	}
-	o := &parseOptions{}
-	for _, opt := range opts {
-		opt(o)
-	}
-
-	if len(o.formats) == 0 {
-		return nil, fmt.Errorf("no format provided")
-	}
-
-	var matchingFormat string
-	var re *regexp.Regexp
-	var groups []string
-	for _, f := range o.formats {
-		f_ := f // save a copy before modifying it
-		if !internal.ValidateFormat(f) {
-			return nil, fmt.Errorf("invalid format: %s", f)
-		}
-
-		f = strings.ReplaceAll(f, ".", `\.`)
-		for _, con := range internal.ValidConventions {
-			f = strings.ReplaceAll(f, con, internal.ConventionsRegex[con])
-		}
-
-		f = `^` + f + `$`
-		currRe := regexp.MustCompile(f)
-		currGroups := currRe.FindStringSubmatch(version)
-		if len(currGroups) > len(groups) {
-			matchingFormat = f_
-			re = currRe
-			groups = currGroups
-		}
-	}
-
-	if len(groups) == 0 {
-		return nil, fmt.Errorf(
-			"version %q does not match any of the provided formats: %q",
-			version,
-			strings.Join(o.formats, ", "),
-		)
-	}
-
-	c := &Version{
-		Format: matchingFormat,
-	}
-	for i, lv := range re.SubexpNames() {
-		if i == 0 {
-			continue
-		}
-		switch lv {
-		case internal.KeyMajor:
-			c.Major = groups[i]
-		case internal.KeyMinor:
-			c.Minor = groups[i]
-		case internal.KeyMicro:
-			c.Micro = groups[i]
-		case internal.KeyModifier:
-			c.Modifier = groups[i]
-		}
-	}
-	if c.Major == "" && c.Minor == "" && c.Micro == "" && c.Modifier == "" {
-		return nil, fmt.Errorf(
-			"malformed calver format: %s - "+
-				"make sure to use at least one version",
-			version,
-		)
+	o, formats, err := newParseOptions(opts)
+	if err != nil {
+		return nil, err
 	}
-
-	return c, nil
+	return parseFormats(version, formats, o)
 }
 
 // String returns the Version object as a string. The string will be in the
@@ -210,16 +213,35 @@ func ParseWithOptions(version string, opts ...parseOption) (*Version, error) {
 //	}
 //	fmt.Println(ver.String()) // Rel-2025-07-14
 func (c *Version) String() string {
-	out := c.Format
-	versionParts := []string{c.Major, c.Minor, c.Micro, c.Modifier}
-	for i, lv := range internal.ValidLevels {
-		for _, con := range internal.ConventionsByLevel[lv] {
-			if versionParts[i] != "" {
-				out = strings.ReplaceAll(out, con, versionParts[i])
-			}
-		}
+	f, err := c.compiledFormat()
+	if err != nil {
+		return c.Format
 	}
-	return out
+	return f.render(func(i int) string { return c.segmentValue(f, i) }, len(f.parts))
+}
+
+// compiledFormat returns the compiled Format of the version. The Format is
+// compiled from the Format field if the Version was not created by parsing or
+// if the Format field has been modified since.
+func (c *Version) compiledFormat() (*Format, error) {
+	if c.format != nil && c.format.raw == c.Format {
+		return c.format, nil
+	}
+	return compileCached(c.Format)
+}
+
+// Clone returns a copy of the version that can be modified without affecting
+// the version, and vice versa.
+//
+// Example:
+//
+//	ver := calver.MustCompileFormat("<YYYY>.<0M>.<0D>.<MICRO>").MustParse("2025.07.14.3")
+//	clone := ver.Clone()
+//	_ = clone.IncSegment(3, calver.BumpOptions{})
+//	fmt.Println(ver, clone) // 2025.07.14.3 2025.07.14.4
+func (c *Version) Clone() *Version {
+	clone := *c
+	return &clone
 }
 
 // GetMajor returns the major version.
@@ -250,28 +272,48 @@ func (c *Version) GetFormat() string {
 // IncMajor increments the major version. If the major version is 0 padded it
 // will retain the 0 padding unless the major version is of the form 09 or 099
 // or 0999 and so on.
+//
+// The lower levels are left unchanged, except for days and ISO weeks that do
+// not exist in the new year, e.g. week 53, which become the last day or week of
+// the year. Use IncLevel with the ResetLower option to reset them.
 func (c *Version) IncMajor() error {
 	major, _ := internal.IncWithPadding(c.Major)
 	c.Major = major
+	if f, err := c.compiledFormat(); err == nil {
+		if i, ok := f.levels[internal.KeyMajor]; ok {
+			c.clampCalendar(f, i)
+		}
+	}
 	return nil
 }
 
 // IncMinor increments the minor version. If the minor version is 0 padded it
 // will retain the 0 padding unless the minor version is of the form 09 or 099
 // or 0999 and so on.
+//
+// If the minor version is a month, i.e. <MM> or <0M>, December rolls over to
+// January of the next major version, so 2025.12 becomes 2026.01. Use IncLevel
+// with the Numeric option to keep the pure numeric behavior.
+//
+// The lower levels are left unchanged, except for days past the end of the new
+// month, which become its last day, so 2025.01.31 becomes 2025.02.28. Use
+// IncLevel with the ResetLower option to reset them.
 func (c *Version) IncMinor() error {
-	minor, _ := internal.IncWithPadding(c.Minor)
-	c.Minor = minor
-	return nil
+	return c.IncLevel(internal.KeyMinor, BumpOptions{})
 }
 
 // IncMicro increments the micro version. If the micro version is 0 padded it
 // will retain the 0 padding unless the micro version is of the form 09 or 099
 // or 0999 and so on.
+//
+// If the micro version is a day, i.e. <DD> or <0D>, and the format has a month,
+// the last day of the month rolls over to the first day of the next month,
+// taking leap years into account. If the micro version is an ISO week, i.e.
+// <WW> or <0W>, and the format has a year, the last week of the year rolls over
+// to the first week of the next year. Use IncLevel with the Numeric option to
+// keep the pure numeric behavior.
 func (c *Version) IncMicro() error {
-	micro, _ := internal.IncWithPadding(c.Micro)
-	c.Micro = micro
-	return nil
+	return c.IncLevel(internal.KeyMicro, BumpOptions{})
 }
 
 // IncModifier increments the modifier version. If the modifier version is 0
@@ -294,7 +336,8 @@ func (c *Version) IncModifier() error {
 // minor version and so on.
 //
 // If no level or an unrecognized level is provided, the series will be the
-// entire version string.
+// entire version string. Use SegmentSeries for formats using several
+// conventions of the same level.
 //
 // Example:
 //
@@ -313,46 +356,227 @@ func (c *Version) Series(level string) string {
 		return c.String()
 	}
 
-	conForLevel := ""
-	for _, con := range internal.ConventionsByLevel[level] {
-		if strings.Contains(c.Format, con) {
-			conForLevel = con
-			break
-		}
+	f, err := c.compiledFormat()
+	if err != nil {
+		return c.String()
 	}
-	if conForLevel == "" {
+	i, ok := f.levels[level]
+	if !ok {
 		return c.String()
 	}
+	return f.render(func(i int) string { return c.segmentValue(f, i) }, f.segments[i].part)
+}
+
+// Segment is a value of a version along with the convention it was parsed
+// with.
+type Segment struct {
+	// Convention is the convention of the segment e.g. <0D>.
+	Convention string
+	// Level is the level of the convention, i.e. "major", "minor", "micro" or
+	// "modifier".
+	Level string
+	// Value is the value of the segment e.g. 14.
+	Value string
+}
 
-	parts := strings.Split(c.GetFormat(), conForLevel)
-	if len(parts) == 1 {
+// Segments returns the segments of the version from the most to the least
+// significant. They are ordered by level and, if the format uses several
+// conventions of a level, by their position in the format string. The first
+// segment of each level is also held by the Major, Minor, Micro or Modifier
+// field. The others are only available through Segments and SetSegment.
+//
+// Example:
+//
+//	ver, err := calver.Parse("<YYYY>.<0M>.<0D>.<MICRO>", "2025.07.14.3")
+//	if err != nil {
+//	    return err
+//	}
+//	for _, seg := range ver.Segments() {
+//	    fmt.Println(seg.Convention, seg.Level, seg.Value)
+//	}
+//	// <YYYY> major 2025
+//	// <0M> minor 07
+//	// <0D> micro 14
+//	// <MICRO> micro 3
+//
+// It returns nil if the format of the version is invalid.
+func (c *Version) Segments() []Segment {
+	f, err := c.compiledFormat()
+	if err != nil {
+		return nil
+	}
+	segments := make([]Segment, len(f.segments))
+	for i, seg := range f.segments {
+		segments[i] = Segment{
+			Convention: seg.convention.Name,
+			Level:      seg.key.level,
+			Value:      c.segmentValue(f, i),
+		}
+	}
+	return segments
+}
+
+// SetSegment sets the value of the i-th segment of the version, see Segments.
+// The value is not validated against the convention.
+//
+// It returns an error if the format of the version is invalid or if i is out
+// of range.
+func (c *Version) SetSegment(i int, value string) error {
+	f, err := c.compiledFormat()
+	if err != nil {
+		return err
+	}
+	if i < 0 || i >= len(f.segments) {
+		return fmt.Errorf("segment %d out of range: format %q has %d segments", i, f.raw, len(f.segments))
+	}
+	c.setSegmentValue(f, i, value)
+	return nil
+}
+
+// SegmentSeries is like Series but truncates the version after its i-th
+// segment, see Segments, so it can also be used with the segments that are not
+// the first of their level. If i is out of range the series is the entire
+// version string.
+//
+// Example:
+//
+//	ver, err := calver.Parse("<YYYY>.<0M>.<0D>.<MICRO>", "2025.07.14.3")
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(ver.SegmentSeries(2)) // 2025.07.14
+//	fmt.Println(ver.SegmentSeries(3)) // 2025.07.14.3
+func (c *Version) SegmentSeries(i int) string {
+	f, err := c.compiledFormat()
+	if err != nil || i < 0 || i >= len(f.segments) {
 		return c.String()
 	}
-	format := parts[0] + conForLevel
+	return f.render(func(i int) string { return c.segmentValue(f, i) }, f.segments[i].part)
+}
 
-	for _, lv := range internal.ValidLevels {
-		cons := internal.ConventionsByLevel[lv]
-		for _, con := range cons {
-			format = strings.ReplaceAll(format, con, getValueForLevel(c, lv))
-		}
-		if lv == level {
-			break
-		}
+// segmentValues returns the values of the version for every segment of the
+// format.
+func (c *Version) segmentValues(f *Format) []string {
+	values := make([]string, len(f.segments))
+	for i := range f.segments {
+		values[i] = c.segmentValue(f, i)
 	}
-	return format
+	return values
 }
 
-func getValueForLevel(c *Version, level string) string {
-	if level == internal.KeyMajor {
-		return c.Major
+// segmentValue returns the value of the version for the i-th segment of the
+// format.
+func (c *Version) segmentValue(f *Format, i int) string {
+	seg := f.segments[i]
+	if seg.extra < 0 {
+		return c.valueForLevel(seg.key.level)
 	}
-	if level == internal.KeyMinor {
-		return c.Minor
+	if values := c.extraValues(); seg.extra < len(values) {
+		return values[seg.extra]
 	}
-	if level == internal.KeyMicro {
-		return c.Micro
+	return ""
+}
+
+// setSegmentValue sets the value of the version for the i-th segment of the
+// format.
+func (c *Version) setSegmentValue(f *Format, i int, value string) {
+	seg := f.segments[i]
+	if seg.extra < 0 {
+		c.setValueForLevel(seg.key.level, value)
+		return
+	}
+	values := c.extraValues()
+	if seg.extra >= len(values) {
+		values = append(values, make([]string, seg.extra+1-len(values))...)
+	}
+	values[seg.extra] = value
+
+	var extra []byte
+	for _, v := range values {
+		extra = appendExtra(extra, v)
+	}
+	c.extra = string(extra)
+}
+
+// appendExtra appends the value, prefixed with its length and a colon, to the
+// encoded extra values, e.g. "3" and "14" are encoded as "1:32:14".
+func appendExtra(extra []byte, value string) []byte {
+	extra = strconv.AppendInt(extra, int64(len(value)), 10)
+	extra = append(extra, ':')
+	return append(extra, value...)
+}
+
+// extraValues decodes the values of the segments that are not the first of
+// their level.
+func (c *Version) extraValues() []string {
+	var values []string
+	for rest := c.extra; rest != ""; {
+		length, value, _ := strings.Cut(rest, ":")
+		n, _ := strconv.Atoi(length)
+		values = append(values, value[:n])
+		rest = value[n:]
+	}
+	return values
+}
+
+// valueAt returns the value of the segment with the given key or an empty
+// string if the format of the version has no such segment. The format is only
+// compiled for segments that are not the first of their level.
+func (c *Version) valueAt(key segmentKey) string {
+	if key.rank == 0 {
+		return c.valueForLevel(key.level)
 	}
-	if level == internal.KeyModifier {
+	if c.extra == "" {
+		return ""
+	}
+	f, err := c.compiledFormat()
+	if err != nil {
+		return ""
+	}
+	i := f.segment(key)
+	if i < 0 {
+		return ""
+	}
+	return c.segmentValue(f, i)
+}
+
+// levelSegments returns the number of segments of the level, which is one
+// unless the format of the version uses several conventions of the level.
+func (c *Version) levelSegments(level string) int {
+	if c.extra == "" {
+		return 1
+	}
+	f, err := c.compiledFormat()
+	if err != nil {
+		return 1
+	}
+	return max(1, f.levelSegments(level))
+}
+
+// setValueForLevel sets the value of the version for the given level.
+func (c *Version) setValueForLevel(level, value string) {
+	switch level {
+	case internal.KeyMajor:
+		c.Major = value
+	case internal.KeyMinor:
+		c.Minor = value
+	case internal.KeyMicro:
+		c.Micro = value
+	case internal.KeyModifier:
+		c.Modifier = value
+	}
+}
+
+// valueForLevel returns the value of the version for the given level.
+func (c *Version) valueForLevel(level string) string {
+	switch level {
+	case internal.KeyMajor:
+		return c.Major
+	case internal.KeyMinor:
+		return c.Minor
+	case internal.KeyMicro:
+		return c.Micro
+	case internal.KeyModifier:
 		return c.Modifier
 	}
 	return ""
@@ -31,6 +31,8 @@ func TestParse(t *testing.T) {
 		{name: "15", format: "<MAJOR>-<MINOR>-<MICRO>", version: "2025-14-12", wantErr: false},
 		{name: "16", format: "v<MAJOR>-<MINOR>-<MICRO>", version: "v2025-14-12", wantErr: false},
 		{name: "16", format: "v<MAJOR>-<MINOR>-<MICRO>", version: "2025-14-12", wantErr: true},
+		{name: "17", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.07.14.3", wantErr: false},
+		{name: "18", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.07.14", wantErr: true},
 	}
 
 	for _, test := range tests {
@@ -182,6 +184,8 @@ func TestVersionSeries(t *testing.T) {
 		{name: "10", format: "<YYYY>-<MM>-<DD>", version: "2025-07-14", level: "modifier", want: "2025-07-14"},
 		{name: "11", format: "<YYYY>-<MM>-<DD>", version: "2025-07-14", level: "", want: "2025-07-14"},
 		{name: "12", format: "v<YYYY>-<MM>-<DD>", version: "v2025-07-14", level: "invalid", want: "v2025-07-14"},
+		{name: "13", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.07.14.3", level: "micro", want: "2025.07.14"},
+		{name: "14", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.07.14.3", level: "", want: "2025.07.14.3"},
 	}
 
 	for _, test := range tests {
@@ -192,3 +196,138 @@ func TestVersionSeries(t *testing.T) {
 		})
 	}
 }
+
+func TestVersionSegments(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		version string
+		want    []calver.Segment
+	}{
+		{
+			name:    "1",
+			format:  "<YYYY>.<0M>.<0D>.<MICRO>",
+			version: "2025.07.14.3",
+			want: []calver.Segment{
+				{Convention: "<YYYY>", Level: "major", Value: "2025"},
+				{Convention: "<0M>", Level: "minor", Value: "07"},
+				{Convention: "<0D>", Level: "micro", Value: "14"},
+				{Convention: "<MICRO>", Level: "micro", Value: "3"},
+			},
+		},
+		{
+			name:    "2",
+			format:  "<MICRO>-<YYYY>.<0M>.<0D>",
+			version: "3-2025.07.14",
+			want: []calver.Segment{
+				{Convention: "<YYYY>", Level: "major", Value: "2025"},
+				{Convention: "<0M>", Level: "minor", Value: "07"},
+				{Convention: "<MICRO>", Level: "micro", Value: "3"},
+				{Convention: "<0D>", Level: "micro", Value: "14"},
+			},
+		},
+		{
+			name:    "3",
+			format:  "<YYYY>.<MAJOR>.<MINOR>.<MINOR>",
+			version: "2025.1.2.3",
+			want: []calver.Segment{
+				{Convention: "<YYYY>", Level: "major", Value: "2025"},
+				{Convention: "<MAJOR>", Level: "major", Value: "1"},
+				{Convention: "<MINOR>", Level: "minor", Value: "2"},
+				{Convention: "<MINOR>", Level: "minor", Value: "3"},
+			},
+		},
+		{
+			name:    "4",
+			format:  "<YYYY>.<0M>-<MODIFIER>",
+			version: "2025.07-rc1",
+			want: []calver.Segment{
+				{Convention: "<YYYY>", Level: "major", Value: "2025"},
+				{Convention: "<0M>", Level: "minor", Value: "07"},
+				{Convention: "<MODIFIER>", Level: "modifier", Value: "rc1"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ver, err := calver.Parse(test.format, test.version)
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, ver.Segments())
+			assert.Equal(t, test.version, ver.String())
+		})
+	}
+}
+
+func TestVersionSetSegment(t *testing.T) {
+	ver, err := calver.Parse("<YYYY>.<0M>.<0D>.<MICRO>", "2025.07.14.3")
+	assert.NoError(t, err)
+	assert.NoError(t, ver.SetSegment(3, "12"))
+	assert.NoError(t, ver.SetSegment(2, "15"))
+	assert.Equal(t, "2025.07.15.12", ver.String())
+	assert.Equal(t, "15", ver.Micro)
+	assert.Error(t, ver.SetSegment(4, "1"))
+	assert.Error(t, ver.SetSegment(-1, "1"))
+
+	ver = &calver.Version{Format: "<YYYY>.<0M>.<0D>.<MICRO>", Major: "2025", Minor: "07", Micro: "14"}
+	assert.Equal(t, "2025.07.14.", ver.String())
+	assert.NoError(t, ver.SetSegment(3, "0"))
+	assert.Equal(t, "0", ver.Segments()[3].Value)
+	assert.Equal(t, "2025.07.14.0", ver.String())
+}
+
+func TestVersionSegmentSeries(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		version string
+		segment int
+		want    string
+	}{
+		{name: "1", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.07.14.3", segment: 0, want: "2025"},
+		{name: "2", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.07.14.3", segment: 2, want: "2025.07.14"},
+		{name: "3", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.07.14.3", segment: 3, want: "2025.07.14.3"},
+		{name: "4", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.07.14.3", segment: 4, want: "2025.07.14.3"},
+		{name: "5", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.07.14.3", segment: -1, want: "2025.07.14.3"},
+		{name: "6", format: "<0M>-<YYYY>", version: "07-2025", segment: 0, want: "07-2025"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ver, err := calver.Parse(test.format, test.version)
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, ver.SegmentSeries(test.segment))
+		})
+	}
+}
+
+func TestVersionClone(t *testing.T) {
+	ver := calver.MustCompileFormat("<YYYY>.<0M>.<0D>.<MICRO>-<MODIFIER>").MustParse("2025.07.14.3-rc1")
+	clone := ver.Clone()
+	assert.Equal(t, ver, clone)
+	assert.NotSame(t, ver, clone)
+
+	assert.NoError(t, clone.SetSegment(3, "4"))
+	clone.Modifier = "rc2"
+	assert.Equal(t, "2025.07.14.3-rc1", ver.String())
+	assert.Equal(t, "3", ver.Segments()[3].Value)
+	assert.Equal(t, "2025.07.14.4-rc2", clone.String())
+
+	ver = &calver.Version{Format: "<YYYY>.<0M>", Major: "2025", Minor: "07"}
+	clone = ver.Clone()
+	assert.Equal(t, ver, clone)
+}
+
+func TestVersionComparable(t *testing.T) {
+	f := calver.MustCompileFormat("<YYYY>.<0M>.<0D>.<MICRO>")
+	a, b := f.MustParse("2025.07.14.3"), f.MustParse("2025.07.14.3")
+	assert.True(t, *a == *b)
+
+	assert.NoError(t, b.SetSegment(3, "4"))
+	assert.False(t, *a == *b)
+	assert.NoError(t, b.SetSegment(3, "3"))
+	assert.True(t, *a == *b)
+
+	seen := map[calver.Version]bool{*a: true}
+	assert.True(t, seen[*b])
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shazib-summar/go-calver"
+)
+
+// parsedVersion is the JSON representation of a version printed by the parse
+// command.
+type parsedVersion struct {
+	Version  string   `json:"version"`
+	Format   string   `json:"format"`
+	Major    string   `json:"major"`
+	Minor    string   `json:"minor"`
+	Micro    string   `json:"micro"`
+	Modifier string   `json:"modifier"`
+	Extra    []string `json:"extra,omitempty"`
+}
+
+func runParse(e *env, args []string) error {
+	fs := newFlagSet(e, "parse")
+	if err := fs.parse(args, 1); err != nil {
+		return err
+	}
+	ver, err := fs.parseVersion(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	// The segments after the first of each level are the extra values.
+	var extra []string
+	seen := map[string]bool{}
+	for _, seg := range ver.Segments() {
+		if seen[seg.Level] {
+			extra = append(extra, seg.Value)
+		}
+		seen[seg.Level] = true
+	}
+
+	enc := json.NewEncoder(e.stdout)
+	enc.SetEscapeHTML(false)
+	return enc.Encode(parsedVersion{
+		Version:  ver.String(),
+		Format:   ver.Format,
+		Major:    ver.Major,
+		Minor:    ver.Minor,
+		Micro:    ver.Micro,
+		Modifier: ver.Modifier,
+		Extra:    extra,
+	})
+}
+
+func runValidate(e *env, args []string) error {
+	fs := newFlagSet(e, "validate")
+	if err := fs.parse(args, -1); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return fmt.Errorf("%w: expected at least one version", errUsage)
+	}
+	invalid := 0
+	for _, version := range fs.Args() {
+		if _, err := fs.parseVersion(version); err != nil {
+			fmt.Fprintln(e.stderr, err)
+			invalid++
+		}
+	}
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d versions are invalid", invalid, fs.NArg())
+	}
+	return nil
+}
+
+func runCompare(e *env, args []string) error {
+	fs := newFlagSet(e, "compare")
+	order := fs.String("modifier-order", "lexical", "how modifiers are compared: lexical, semver or natural")
+	if err := fs.parse(args, 2); err != nil {
+		return err
+	}
+	modifierOrder, err := parseModifierOrder(*order)
+	if err != nil {
+		return err
+	}
+	a, err := fs.parseVersion(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	b, err := fs.parseVersion(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(e.stdout, a.Compare(b, calver.WithModifierOrder(modifierOrder)))
+	return nil
+}
+
+func runSort(e *env, args []string) error {
+	fs := newFlagSet(e, "sort")
+	order := fs.String("modifier-order", "lexical", "how modifiers are compared: lexical, semver or natural")
+	reverse := fs.Bool("reverse", false, "sort in descending order")
+	if err := fs.parse(args, 0); err != nil {
+		return err
+	}
+	modifierOrder, err := parseModifierOrder(*order)
+	if err != nil {
+		return err
+	}
+
+	var versions []string
+	scanner := bufio.NewScanner(e.stdin)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			versions = append(versions, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	collection, err := fs.parseCollection(versions)
+	if err != nil {
+		return err
+	}
+	collection.SortWith(calver.WithModifierOrder(modifierOrder))
+	for i := range collection {
+		if *reverse {
+			i = len(collection) - 1 - i
+		}
+		fmt.Fprintln(e.stdout, collection[i].String())
+	}
+	return nil
+}
+
+func runBump(e *env, args []string) error {
+	fs := newFlagSet(e, "bump")
+	var opts calver.BumpOptions
+	fs.BoolVar(&opts.ResetLower, "reset-lower", false, "reset the levels below the incremented level")
+	fs.BoolVar(&opts.Numeric, "numeric", false, "increment calendar levels without rolling over")
+	if err := fs.parse(args, 2); err != nil {
+		return err
+	}
+	level, err := parseLevel(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	ver, err := fs.parseVersion(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	bumped, err := ver.Bump(level, opts)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(e.stdout, bumped.String())
+	return nil
+}
+
+func runNext(e *env, args []string) error {
+	fs := newFlagSet(e, "next")
+	date := fs.String("date", "", "release date as YYYY-MM-DD, defaults to today in UTC")
+	modifier := fs.String("modifier", "", "modifier of the next version")
+	if err := fs.parse(args, -1); err != nil {
+		return err
+	}
+	if fs.NArg() > 1 {
+		return fmt.Errorf("%w: expected at most 1 argument, got %d", errUsage, fs.NArg())
+	}
+
+	now, err := parseDate(*date)
+	if err != nil {
+		return err
+	}
+
+	var next *calver.Version
+	if fs.NArg() == 0 {
+		// Without a previous version, the next version is the first one of
+		// the first format for the date.
+		f, err := calver.CompileFormat(fs.formats[0])
+		if err != nil {
+			return err
+		}
+		next, err = f.FromTime(now)
+		if err != nil {
+			return err
+		}
+		if *modifier == "" && requiresModifier(f, next) {
+			return fmt.Errorf("%w: format %q requires --modifier", errUsage, f.String())
+		}
+		next.Modifier = *modifier
+	} else {
+		ver, err := fs.parseVersion(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		next, err = ver.Next(now, calver.WithNextModifier(*modifier))
+		if errors.Is(err, calver.ErrNoModifier) {
+			return fmt.Errorf("%w: %v, use --modifier", errUsage, err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	fmt.Fprintln(e.stdout, next.String())
+	return nil
+}
+
+func runSeries(e *env, args []string) error {
+	fs := newFlagSet(e, "series")
+	if err := fs.parse(args, 2); err != nil {
+		return err
+	}
+	level, err := parseLevel(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	ver, err := fs.parseVersion(fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(e.stdout, ver.Series(level))
+	return nil
+}
+
+// requiresModifier reports whether the format has a modifier outside of an
+// optional section, using a version of the format as a probe. An empty optional modifier is rendered
+// without the literal text of its section, so setting a one character
+// modifier lengthens the version by more than one byte.
+func requiresModifier(f *calver.Format, ver *calver.Version) bool {
+	if f.Convention("modifier") == "" {
+		return false
+	}
+	probe := *ver
+	probe.Modifier = ""
+	empty := probe.String()
+	probe.Modifier = "x"
+	return len(probe.String()) == len(empty)+1
+}
+
+// parseDate parses a date given as YYYY-MM-DD. An empty date is today in UTC.
+func parseDate(date string) (time.Time, error) {
+	if date == "" {
+		return time.Now().UTC(), nil
+	}
+	t, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid --date: %v", errUsage, err)
+	}
+	return t, nil
+}
+
+// parseLevel returns the level with the given name, ignoring case.
+func parseLevel(name string) (string, error) {
+	level := strings.ToLower(name)
+	switch level {
+	case "major", "minor", "micro", "modifier":
+		return level, nil
+	}
+	return "", fmt.Errorf("%w: unknown level %q", errUsage, name)
+}
+
+// parseModifierOrder returns the modifier order with the given name.
+func parseModifierOrder(name string) (calver.ModifierOrder, error) {
+	switch strings.ToLower(name) {
+	case "lexical":
+		return calver.ModifierLexical, nil
+	case "semver":
+		return calver.ModifierSemVer, nil
+	case "natural":
+		return calver.ModifierNatural, nil
+	}
+	return 0, fmt.Errorf("%w: unknown modifier order %q", errUsage, name)
+}
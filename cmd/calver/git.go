@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shazib-summar/go-calver/gitver"
+)
+
+func runGit(e *env, args []string) error {
+	fs := newFlagSet(e, "git")
+	dir := fs.String("dir", ".", "path of the git repository")
+	list := fs.Bool("list", false, "print every matching tag in ascending order")
+	series := fs.String("series", "", "print the latest tag of every series at the level")
+	next := fs.Bool("next", false, "print the next tag for --date")
+	date := fs.String("date", "", "release date as YYYY-MM-DD, defaults to today in UTC")
+	quiet := fs.Bool("quiet", false, "do not report tags that do not match the formats")
+	if err := fs.parse(args, 0); err != nil {
+		return err
+	}
+	modes := 0
+	for _, set := range []bool{*list, *series != "", *next} {
+		if set {
+			modes++
+		}
+	}
+	if modes > 1 {
+		return fmt.Errorf("%w: --list, --series and --next are mutually exclusive", errUsage)
+	}
+	now, err := parseDate(*date)
+	if err != nil {
+		return err
+	}
+
+	tags, err := gitver.Discover(*dir, fs.formats...)
+	if err != nil {
+		return err
+	}
+	if !*quiet {
+		for _, skipped := range tags.Skipped {
+			fmt.Fprintf(e.stderr, "skipped tag %s: %v\n", skipped.Tag, skipped.Err)
+		}
+	}
+
+	switch {
+	case *next:
+		ver, err := tags.Next(now)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(e.stdout, ver.String())
+		return nil
+	case len(tags.Versions) == 0:
+		return errors.New("no tag matches the formats")
+	case *list:
+		for _, ver := range tags.Versions {
+			fmt.Fprintln(e.stdout, ver.String())
+		}
+	case *series != "":
+		for _, ver := range tags.LatestPerSeries(*series) {
+			fmt.Fprintln(e.stdout, ver.String())
+		}
+	default:
+		fmt.Fprintln(e.stdout, tags.Latest().String())
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/shazib-summar/go-calver/internal/gittest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunGit(t *testing.T) {
+	dir := gittest.NewRepo(t, "v2025.06.0", "v2025.06.1", "v2025.07.0", "nightly")
+
+	const format = "--format=v<YYYY>.<0M>.<MICRO>"
+	tests := []struct {
+		name       string
+		args       []string
+		want       string
+		wantStderr string
+		wantCode   int
+	}{
+		{name: "1", args: []string{"git", format, "--dir", dir}, want: "v2025.07.0\n", wantStderr: "skipped tag nightly"},
+		{name: "2", args: []string{"git", format, "--dir", dir, "--quiet", "--list"}, want: "v2025.06.0\nv2025.06.1\nv2025.07.0\n"},
+		{name: "3", args: []string{"git", format, "--dir", dir, "--quiet", "--series", "minor"}, want: "v2025.06.1\nv2025.07.0\n"},
+		{name: "4", args: []string{"git", format, "--dir", dir, "--quiet", "--next", "--date", "2025-07-20"}, want: "v2025.07.1\n"},
+		{name: "5", args: []string{"git", format, "--dir", dir, "--quiet", "--next", "--date", "2025-08-02"}, want: "v2025.08.0\n"},
+		{name: "6", args: []string{"git", format, "--dir", dir, "--quiet", "--next", "--date", "2025-05-02"}, wantCode: exitFail},
+		{name: "7", args: []string{"git", "--format=<YYYY>", "--dir", dir, "--quiet"}, wantCode: exitFail},
+		{name: "8", args: []string{"git", "--format=<YYYY>", "--dir", dir, "--quiet", "--next", "--date", "2025-08-02"}, want: "2025\n"},
+		{name: "9", args: []string{"git", format, "--dir", dir, "--list", "--next"}, wantCode: exitUsage},
+		{name: "10", args: []string{"git", format, "--dir", t.TempDir()}, wantCode: exitFail},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(test.args, &env{
+				stdin:  strings.NewReader(""),
+				stdout: &stdout,
+				stderr: &stderr,
+			})
+			assert.Equal(t, test.wantCode, code, stderr.String())
+			assert.Equal(t, test.want, stdout.String())
+			assert.Contains(t, stderr.String(), test.wantStderr)
+		})
+	}
+}
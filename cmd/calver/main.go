@@ -0,0 +1,184 @@
+// Command calver parses, validates, compares, sorts and increments CalVer
+// versions. It is meant to be used in shell based release scripts.
+//
+// Usage:
+//
+//	calver <command> [flags] [arguments]
+//
+// The commands are:
+//
+//	parse     print the levels of a version as JSON
+//	validate  check that versions match the format
+//	compare   print -1, 0 or 1 comparing two versions
+//	sort      sort the versions read from stdin, one per line
+//	bump      increment a level of a version
+//	next      print the next version for a date
+//	series    print the series of a version at a level
+//	git       print the latest, every or the next tag of a git repository
+//
+// Every command accepts one or more --format flags. Flags must come before the
+// arguments, e.g. calver parse --format "<YYYY>.<0M>.<0D>" 2025.07.14.
+//
+// The exit code is 0 on success, 1 if a version is invalid or the command
+// fails, and 2 if the command is used incorrectly.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/shazib-summar/go-calver"
+)
+
+const (
+	exitOK    = 0
+	exitFail  = 1
+	exitUsage = 2
+)
+
+// errUsage is returned by commands that are used incorrectly.
+var errUsage = errors.New("invalid usage")
+
+// env holds the standard streams of the command.
+type env struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+type command struct {
+	name  string
+	usage string
+	run   func(e *env, args []string) error
+}
+
+var commands = []*command{
+	{name: "parse", usage: "parse --format FORMAT... VERSION", run: runParse},
+	{name: "validate", usage: "validate --format FORMAT... [--strict] VERSION...", run: runValidate},
+	{name: "compare", usage: "compare --format FORMAT... [--modifier-order ORDER] VERSION VERSION", run: runCompare},
+	{name: "sort", usage: "sort --format FORMAT... [--modifier-order ORDER] [--reverse] < VERSIONS", run: runSort},
+	{name: "bump", usage: "bump --format FORMAT... [--reset-lower] [--numeric] LEVEL VERSION", run: runBump},
+	{name: "next", usage: "next --format FORMAT... [--date YYYY-MM-DD] [--modifier MODIFIER] [VERSION]", run: runNext},
+	{name: "series", usage: "series --format FORMAT... LEVEL VERSION", run: runSeries},
+	{name: "git", usage: "git --format FORMAT... [--dir DIR] [--list | --series LEVEL | --next [--date YYYY-MM-DD]] [--quiet]", run: runGit},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], &env{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}))
+}
+
+// run runs the command given by args and returns the exit code.
+func run(args []string, e *env) int {
+	if len(args) == 0 {
+		usage(e.stderr)
+		return exitUsage
+	}
+	name := args[0]
+	if name == "help" || name == "-h" || name == "--help" {
+		usage(e.stdout)
+		return exitOK
+	}
+	for _, cmd := range commands {
+		if cmd.name != name {
+			continue
+		}
+		err := cmd.run(e, args[1:])
+		switch {
+		case err == nil:
+			return exitOK
+		case errors.Is(err, flag.ErrHelp):
+			return exitOK
+		case errors.Is(err, errUsage):
+			fmt.Fprintf(e.stderr, "calver %s: %v\nusage: calver %s\n", name, err, cmd.usage)
+			return exitUsage
+		}
+		fmt.Fprintf(e.stderr, "calver %s: %v\n", name, err)
+		return exitFail
+	}
+	fmt.Fprintf(e.stderr, "calver: unknown command %q\n", name)
+	usage(e.stderr)
+	return exitUsage
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: calver <command> [flags] [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  calver %s\n", cmd.usage)
+	}
+}
+
+// formatsFlag is a flag that may be given multiple times, each adding a
+// format.
+type formatsFlag []string
+
+func (f *formatsFlag) String() string {
+	return strings.Join(*f, ", ")
+}
+
+func (f *formatsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// flagSet is a flag.FlagSet with the flags shared by all commands.
+type flagSet struct {
+	*flag.FlagSet
+	formats formatsFlag
+	strict  bool
+}
+
+func newFlagSet(e *env, name string) *flagSet {
+	fs := &flagSet{FlagSet: flag.NewFlagSet(name, flag.ContinueOnError)}
+	fs.SetOutput(e.stderr)
+	fs.Var(&fs.formats, "format", "format of the versions, may be given multiple times")
+	fs.BoolVar(&fs.strict, "strict", false, "reject versions with invalid calendar dates")
+	return fs
+}
+
+// parse parses the flags and checks that at least one format and nargs
+// arguments are given. A negative nargs allows any number of arguments.
+func (fs *flagSet) parse(args []string, nargs int) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return fmt.Errorf("%w: %v", errUsage, err)
+	}
+	if len(fs.formats) == 0 {
+		return fmt.Errorf("%w: at least one --format is required", errUsage)
+	}
+	if nargs >= 0 && fs.NArg() != nargs {
+		return fmt.Errorf("%w: expected %d arguments, got %d", errUsage, nargs, fs.NArg())
+	}
+	return nil
+}
+
+// parseVersion parses the version using the formats of the flag set.
+func (fs *flagSet) parseVersion(version string) (*calver.Version, error) {
+	if fs.strict {
+		return calver.ParseWithOptions(
+			version,
+			calver.WithFormat(fs.formats...),
+			calver.WithStrictCalendar(),
+		)
+	}
+	return calver.ParseWithOptions(version, calver.WithFormat(fs.formats...))
+}
+
+// parseCollection parses the versions using the formats of the flag set.
+func (fs *flagSet) parseCollection(versions []string) (calver.Collection, error) {
+	if fs.strict {
+		return calver.NewCollectionWithOptions(
+			versions,
+			calver.WithFormat(fs.formats...),
+			calver.WithStrictCalendar(),
+		)
+	}
+	return calver.NewCollectionWithOptions(versions, calver.WithFormat(fs.formats...))
+}
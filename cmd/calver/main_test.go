@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	const ymd = "--format=<YYYY>.<0M>.<0D>"
+	const ymdMod = "--format=<YYYY>.<0M>.<0D>-<MODIFIER>"
+	const ymMicro = "--format=<YYYY>.<0M>.<MICRO>"
+
+	tests := []struct {
+		name     string
+		args     []string
+		stdin    string
+		want     string
+		wantCode int
+	}{
+		{name: "1", args: nil, wantCode: exitUsage},
+		{name: "2", args: []string{"unknown"}, wantCode: exitUsage},
+		{name: "3", args: []string{"parse", "2025.07.14"}, wantCode: exitUsage},
+		{name: "4", args: []string{"parse", ymd}, wantCode: exitUsage},
+		{name: "5", args: []string{"parse", "--unknown", ymd, "2025.07.14"}, wantCode: exitUsage},
+		{
+			name: "6",
+			args: []string{"parse", ymd, ymdMod, "2025.07.14-rc1"},
+			want: `{"version":"2025.07.14-rc1","format":"<YYYY>.<0M>.<0D>-<MODIFIER>","major":"2025","minor":"07","micro":"14","modifier":"rc1"}` + "\n",
+		},
+		{name: "7", args: []string{"parse", ymd, "2025.7.14"}, wantCode: exitFail},
+		{name: "8", args: []string{"validate", ymd, "2025.07.14", "2025.02.30"}},
+		{name: "9", args: []string{"validate", ymd, "--strict", "2025.07.14", "2025.02.30"}, wantCode: exitFail},
+		{name: "10", args: []string{"validate", ymd, "2025.07"}, wantCode: exitFail},
+		{name: "11", args: []string{"validate", ymd}, wantCode: exitUsage},
+		{name: "12", args: []string{"compare", ymd, "2025.07.14", "2025.07.15"}, want: "-1\n"},
+		{name: "13", args: []string{"compare", ymd, "2025.07.14", "2025.07.14"}, want: "0\n"},
+		{name: "14", args: []string{"compare", ymd, ymdMod, "2025.07.14-rc1", "2025.07.14"}, want: "1\n"},
+		{
+			name: "15",
+			args: []string{"compare", ymd, ymdMod, "--modifier-order=semver", "2025.07.14-rc1", "2025.07.14"},
+			want: "-1\n",
+		},
+		{name: "16", args: []string{"compare", ymd, "--modifier-order=other", "2025.07.14", "2025.07.14"}, wantCode: exitUsage},
+		{name: "17", args: []string{"compare", ymd, "2025.07.14"}, wantCode: exitUsage},
+		{
+			name:  "18",
+			args:  []string{"sort", ymMicro},
+			stdin: "2025.07.10\n2025.07.9\n\n2024.12.0\n",
+			want:  "2024.12.0\n2025.07.9\n2025.07.10\n",
+		},
+		{
+			name:  "19",
+			args:  []string{"sort", ymMicro, "--reverse"},
+			stdin: "2025.07.10\n2025.07.9\n2024.12.0\n",
+			want:  "2025.07.10\n2025.07.9\n2024.12.0\n",
+		},
+		{name: "20", args: []string{"sort", ymMicro}, stdin: "2025.07.10\nlatest\n", wantCode: exitFail},
+		{name: "21", args: []string{"bump", ymMicro, "micro", "2025.07.9"}, want: "2025.07.10\n"},
+		{name: "22", args: []string{"bump", ymMicro, "minor", "2025.12.9"}, want: "2026.01.9\n"},
+		{name: "23", args: []string{"bump", ymMicro, "--reset-lower", "minor", "2025.07.9"}, want: "2025.08.0\n"},
+		{name: "24", args: []string{"bump", ymMicro, "--numeric", "minor", "2025.12.9"}, want: "2025.13.9\n"},
+		{name: "25", args: []string{"bump", ymMicro, "patch", "2025.07.9"}, wantCode: exitUsage},
+		{name: "26", args: []string{"next", ymMicro, "--date=2025-07-20", "2025.07.3"}, want: "2025.07.4\n"},
+		{name: "27", args: []string{"next", ymMicro, "--date=2025-08-01", "2025.07.3"}, want: "2025.08.0\n"},
+		{name: "28", args: []string{"next", ymMicro, "--date=2025-06-01", "2025.07.3"}, wantCode: exitFail},
+		{name: "29", args: []string{"next", ymMicro, "--date=2025-08-01"}, want: "2025.08.0\n"},
+		{name: "30", args: []string{"next", ymdMod, "--date=2025-08-01", "--modifier=rc1"}, want: "2025.08.01-rc1\n"},
+		{name: "31", args: []string{"next", ymMicro, "--date=yesterday"}, wantCode: exitUsage},
+		{name: "32", args: []string{"series", ymd, "minor", "2025.07.14"}, want: "2025.07\n"},
+		{name: "33", args: []string{"series", ymd, "major", "2025.07.14"}, want: "2025\n"},
+		{name: "34", args: []string{"help"}, want: ""},
+		{
+			name: "35",
+			args: []string{"parse", "--format=<YYYY>.<0M>.<0D>.<MICRO>", "2025.07.14.3"},
+			want: `{"version":"2025.07.14.3","format":"<YYYY>.<0M>.<0D>.<MICRO>","major":"2025","minor":"07","micro":"14","modifier":"","extra":["3"]}` + "\n",
+		},
+		{name: "36", args: []string{"next", ymdMod, "--date=2025-08-01"}, wantCode: exitUsage},
+		{name: "37", args: []string{"next", ymdMod, "--date=2025-08-01", "2025.07.14-rc1"}, wantCode: exitUsage},
+		{
+			name: "38",
+			args: []string{"next", ymdMod, "--date=2025-08-01", "--modifier=rc1", "2025.07.14-rc2"},
+			want: "2025.08.01-rc1\n",
+		},
+		{name: "39", args: []string{"next", "--format=<YYYY>.<0M>.<MICRO>[-<MODIFIER>]", "--date=2025-08-01"}, want: "2025.08.0\n"},
+		{name: "40", args: []string{"bump", ymMicro, "MINOR", "2025.07.9"}, want: "2025.08.9\n"},
+		{name: "41", args: []string{"series", ymd, "patch", "2025.07.14"}, wantCode: exitUsage},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(test.args, &env{
+				stdin:  strings.NewReader(test.stdin),
+				stdout: &stdout,
+				stderr: &stderr,
+			})
+			assert.Equal(t, test.wantCode, code, stderr.String())
+			if test.want != "" {
+				assert.Equal(t, test.want, stdout.String())
+			}
+			if code != exitOK {
+				assert.NotEmpty(t, stderr.String())
+			}
+		})
+	}
+}
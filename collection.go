@@ -1,9 +1,17 @@
 package calver
 
-import "fmt"
+import (
+	"slices"
+	"sort"
+)
 
 // Collection is a collection of Version objects. It implements the
 // sort.Interface interface.
+//
+// Apart from sorting with sort.Sort or SortWith, the methods of a Collection
+// return new collections and leave the collection unchanged. The new
+// collections hold the same versions, so a version must not be modified while
+// it is shared; use Clone to get a collection of copies.
 type Collection []*Version
 
 // NewCollection creates a new Collection from a format string and a list of
@@ -41,6 +49,197 @@ func (c Collection) Swap(i, j int) {
 	c[i], c[j] = c[j], c[i]
 }
 
+// SortWith sorts the collection in place in ascending order using the given
+// compare options. The sort is stable. Calling SortWith without options is the
+// same as calling sort.Stable on the collection.
+//
+// Example:
+//
+//	collection, err := calver.NewCollectionWithOptions(
+//	    []string{"2025.07.14", "2025.07.14-rc.10", "2025.07.14-rc.2"},
+//	    calver.WithFormat("<YYYY>.<0M>.<0D>", "<YYYY>.<0M>.<0D>-<MODIFIER>"),
+//	)
+//	if err != nil {
+//	    return err
+//	}
+//	collection.SortWith(calver.WithModifierOrder(calver.ModifierSemVer))
+//	// 2025.07.14-rc.2, 2025.07.14-rc.10, 2025.07.14
+func (c Collection) SortWith(opts ...compareOption) {
+	sort.SliceStable(c, func(i, j int) bool {
+		return c[i].Compare(c[j], opts...) < 0
+	})
+}
+
+// Sorted returns a copy of the collection sorted in ascending order using the
+// given compare options. The sort is stable and the collection itself is left
+// unchanged.
+//
+// Example:
+//
+//	collection, _ := calver.NewCollection("<YYYY>.<0M>.<MICRO>", "2025.07.3", "2025.01.0")
+//	fmt.Println(collection.Sorted()) // [2025.01.0 2025.07.3]
+func (c Collection) Sorted(opts ...compareOption) Collection {
+	sorted := slices.Clone(c)
+	sorted.SortWith(opts...)
+	return sorted
+}
+
+// SortedDesc is like Sorted but sorts the copy in descending order. Equal
+// versions keep their order.
+func (c Collection) SortedDesc(opts ...compareOption) Collection {
+	sorted := slices.Clone(c)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Compare(sorted[j], opts...) > 0
+	})
+	return sorted
+}
+
+// Latest returns the greatest version of the collection according to Compare
+// with the given options or nil if the collection is empty. If several
+// versions are equal, the first of them is returned.
+//
+// Example:
+//
+//	collection, _ := calver.NewCollection("<YYYY>.<0M>.<MICRO>", "2025.07.3", "2025.10.0", "2025.01.0")
+//	fmt.Println(collection.Latest()) // 2025.10.0
+func (c Collection) Latest(opts ...compareOption) *Version {
+	var latest *Version
+	for _, v := range c {
+		if latest == nil || v.Compare(latest, opts...) > 0 {
+			latest = v
+		}
+	}
+	return latest
+}
+
+// Oldest returns the smallest version of the collection according to Compare
+// with the given options or nil if the collection is empty. If several
+// versions are equal, the first of them is returned.
+func (c Collection) Oldest(opts ...compareOption) *Version {
+	var oldest *Version
+	for _, v := range c {
+		if oldest == nil || v.Compare(oldest, opts...) < 0 {
+			oldest = v
+		}
+	}
+	return oldest
+}
+
+// Filter returns a new collection holding the versions for which keep returns
+// true, in their original order.
+//
+// Example:
+//
+//	collection, _ := calver.NewCollection("<YYYY>.<0M>.<MICRO>", "2024.12.1", "2025.07.3", "2025.10.0")
+//	recent := collection.Filter(func(v *calver.Version) bool {
+//	    return v.Major == "2025"
+//	})
+//	fmt.Println(recent) // [2025.07.3 2025.10.0]
+func (c Collection) Filter(keep func(*Version) bool) Collection {
+	filtered := Collection{}
+	for _, v := range c {
+		if keep(v) {
+			filtered = append(filtered, v)
+		}
+	}
+	return filtered
+}
+
+// GroupBySeries groups the versions by their series at the given level, see
+// Version.Series. The versions of each group are in their original order.
+//
+// Example:
+//
+//	collection, _ := calver.NewCollection("<YYYY>.<0M>.<MICRO>", "2025.07.3", "2025.10.0", "2025.07.4")
+//	groups := collection.GroupBySeries("minor")
+//	fmt.Println(groups["2025.07"]) // [2025.07.3 2025.07.4]
+//	fmt.Println(groups["2025.10"]) // [2025.10.0]
+func (c Collection) GroupBySeries(level string) map[string]Collection {
+	groups := map[string]Collection{}
+	for _, v := range c {
+		series := v.Series(level)
+		groups[series] = append(groups[series], v)
+	}
+	return groups
+}
+
+// LatestPerSeries returns the latest version of every series at the given
+// level, see GroupBySeries and Latest, sorted in ascending order.
+//
+// Example:
+//
+//	collection, _ := calver.NewCollection(
+//	    "<YYYY>.<0M>.<MICRO>",
+//	    "2025.07.3", "2025.10.0", "2025.07.4", "2025.10.1", "2025.08.0",
+//	)
+//	fmt.Println(collection.LatestPerSeries("minor")) // [2025.07.4 2025.08.0 2025.10.1]
+func (c Collection) LatestPerSeries(level string, opts ...compareOption) Collection {
+	latest := Collection{}
+	for _, group := range c.GroupBySeries(level) {
+		latest = append(latest, group.Latest(opts...))
+	}
+	latest.SortWith(opts...)
+	return latest
+}
+
+// Dedupe returns a new collection without the versions that are equal,
+// according to Compare with the given options, to a version before them. The
+// remaining versions are in their original order.
+//
+// Example:
+//
+//	collection, _ := calver.NewCollectionWithOptions(
+//	    []string{"2025.07.3", "2025.07.03", "2025.10.0"},
+//	    calver.WithFormat("<YYYY>.<0M>.<MICRO>"),
+//	)
+//	fmt.Println(collection.Dedupe()) // [2025.07.3 2025.10.0]
+func (c Collection) Dedupe(opts ...compareOption) Collection {
+	// Sorting the indices of the versions brings equal versions together,
+	// with the first of them in the collection leading.
+	order := make([]int, len(c))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return c[order[i]].Compare(c[order[j]], opts...) < 0
+	})
+	duplicate := make([]bool, len(c))
+	for k := 1; k < len(order); k++ {
+		if c[order[k]].Compare(c[order[k-1]], opts...) == 0 {
+			duplicate[order[k]] = true
+		}
+	}
+
+	deduped := Collection{}
+	for i, v := range c {
+		if !duplicate[i] {
+			deduped = append(deduped, v)
+		}
+	}
+	return deduped
+}
+
+// Clone returns a new collection holding a copy of every version of the
+// collection, see Version.Clone, so that the versions of either collection can
+// be modified without affecting the other.
+//
+// Example:
+//
+//	collection, _ := calver.NewCollection("<YYYY>.<0M>.<MICRO>", "2025.07.3", "2025.10.0")
+//	clone := collection.Clone()
+//	_ = clone[0].IncMicro()
+//	fmt.Println(collection, clone) // [2025.07.3 2025.10.0] [2025.07.4 2025.10.0]
+func (c Collection) Clone() Collection {
+	if c == nil {
+		return nil
+	}
+	clone := make(Collection, len(c))
+	for i, v := range c {
+		clone[i] = v.Clone()
+	}
+	return clone
+}
+
 // NewCollectionWithOptions creates a new `Collection` from a list of versions and
 // a list of parse options. It will return an error if any of the versions do
 // not match (any of) the format or if no options are provided.
@@ -72,21 +271,14 @@ func (c Collection) Swap(i, j int) {
 //	    return err
 //	}
 func NewCollectionWithOptions(versions []string, opts ...parseOption) (Collection, error) {
-	if len(opts) == 0 {
-		return nil, fmt.Errorf("at least one parseOption is required")
-	}
-	o := &parseOptions{}
-	for _, opt := range opts {
-		opt(o)
-	}
-
-	if len(o.formats) == 0 {
-		return nil, fmt.Errorf("no format provided")
+	o, formats, err := newParseOptions(opts)
+	if err != nil {
+		return nil, err
 	}
 
 	collection := make(Collection, len(versions))
 	for i, version := range versions {
-		calver, err := ParseWithOptions(version, opts...)
+		calver, err := parseFormats(version, formats, o)
 		if err != nil {
 			return nil, err
 		}
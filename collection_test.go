@@ -242,3 +242,212 @@ func TestSortCollection(t *testing.T) {
 		})
 	}
 }
+
+func TestCollectionSortWith(t *testing.T) {
+	tests := []struct {
+		name     string
+		order    calver.ModifierOrder
+		versions []string
+		want     []string
+	}{
+		{
+			name:     "1",
+			order:    calver.ModifierLexical,
+			versions: []string{"2025.07.14", "2025.07.14-rc10", "2025.07.14-rc2", "2025.07.13"},
+			want:     []string{"2025.07.13", "2025.07.14", "2025.07.14-rc10", "2025.07.14-rc2"},
+		},
+		{
+			name:     "2",
+			order:    calver.ModifierSemVer,
+			versions: []string{"2025.07.14", "2025.07.14-rc.10", "2025.07.14-rc.2", "2025.07.14-alpha.1", "2025.07.13"},
+			want:     []string{"2025.07.13", "2025.07.14-alpha.1", "2025.07.14-rc.2", "2025.07.14-rc.10", "2025.07.14"},
+		},
+		{
+			name:     "3",
+			order:    calver.ModifierNatural,
+			versions: []string{"2025.07.14", "2025.07.14-rc10", "2025.07.14-rc2", "2025.07.13"},
+			want:     []string{"2025.07.13", "2025.07.14-rc2", "2025.07.14-rc10", "2025.07.14"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collection, err := calver.NewCollectionWithOptions(
+				tt.versions,
+				calver.WithFormat("<YYYY>.<0M>.<0D>", "<YYYY>.<0M>.<0D>-<MODIFIER>"),
+			)
+			assert.NoError(t, err)
+			collection.SortWith(calver.WithModifierOrder(tt.order))
+			for i, v := range collection {
+				assert.Equal(t, tt.want[i], v.String())
+			}
+		})
+	}
+}
+
+// versionStrings returns the string representations of the versions.
+func versionStrings(c calver.Collection) []string {
+	strs := []string{}
+	for _, v := range c {
+		strs = append(strs, v.String())
+	}
+	return strs
+}
+
+func TestCollectionSorted(t *testing.T) {
+	collection, err := calver.NewCollection("<YYYY>.<0M>.<MICRO>", "2025.07.3", "2025.01.0", "2025.07.03", "2024.12.9")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"2024.12.9", "2025.01.0", "2025.07.3", "2025.07.03"}, versionStrings(collection.Sorted()))
+	assert.Equal(t, []string{"2025.07.3", "2025.07.03", "2025.01.0", "2024.12.9"}, versionStrings(collection.SortedDesc()))
+	assert.Equal(t, []string{"2025.07.3", "2025.01.0", "2025.07.03", "2024.12.9"}, versionStrings(collection))
+	assert.Empty(t, calver.Collection{}.Sorted())
+}
+
+func TestCollectionLatestOldest(t *testing.T) {
+	tests := []struct {
+		name       string
+		versions   []string
+		order      calver.ModifierOrder
+		wantLatest string
+		wantOldest string
+	}{
+		{name: "1", versions: []string{"2025.07.14", "2025.10.01", "2025.01.31"}, wantLatest: "2025.10.01", wantOldest: "2025.01.31"},
+		{name: "2", versions: []string{"2025.07.14"}, wantLatest: "2025.07.14", wantOldest: "2025.07.14"},
+		{
+			name:       "3",
+			versions:   []string{"2025.07.14-rc.10", "2025.07.14-rc.2", "2025.07.14"},
+			order:      calver.ModifierSemVer,
+			wantLatest: "2025.07.14",
+			wantOldest: "2025.07.14-rc.2",
+		},
+		{
+			name:       "4",
+			versions:   []string{"2025.07.14-rc.10", "2025.07.14-rc.2", "2025.07.14"},
+			order:      calver.ModifierLexical,
+			wantLatest: "2025.07.14-rc.2",
+			wantOldest: "2025.07.14",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collection, err := calver.NewCollectionWithOptions(
+				tt.versions,
+				calver.WithFormat("<YYYY>.<0M>.<0D>", "<YYYY>.<0M>.<0D>-<MODIFIER>"),
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantLatest, collection.Latest(calver.WithModifierOrder(tt.order)).String())
+			assert.Equal(t, tt.wantOldest, collection.Oldest(calver.WithModifierOrder(tt.order)).String())
+		})
+	}
+
+	assert.Nil(t, calver.Collection{}.Latest())
+	assert.Nil(t, calver.Collection{}.Oldest())
+
+	collection, err := calver.NewCollection("<YYYY>.<0M>.<MICRO>", "2025.07.03", "2025.07.3")
+	assert.NoError(t, err)
+	assert.Same(t, collection[0], collection.Latest())
+	assert.Same(t, collection[0], collection.Oldest())
+}
+
+func TestCollectionFilter(t *testing.T) {
+	collection, err := calver.NewCollection("<YYYY>.<0M>.<MICRO>", "2024.12.1", "2025.07.3", "2025.10.0")
+	assert.NoError(t, err)
+
+	recent := collection.Filter(func(v *calver.Version) bool { return v.Major == "2025" })
+	assert.Equal(t, []string{"2025.07.3", "2025.10.0"}, versionStrings(recent))
+	assert.Empty(t, collection.Filter(func(v *calver.Version) bool { return false }))
+	assert.Len(t, collection, 3)
+}
+
+func TestCollectionGroupBySeries(t *testing.T) {
+	collection, err := calver.NewCollection("<YYYY>.<0M>.<MICRO>", "2025.07.3", "2025.10.0", "2024.07.1", "2025.07.4")
+	assert.NoError(t, err)
+
+	groups := collection.GroupBySeries("minor")
+	assert.Len(t, groups, 3)
+	assert.Equal(t, []string{"2025.07.3", "2025.07.4"}, versionStrings(groups["2025.07"]))
+	assert.Equal(t, []string{"2025.10.0"}, versionStrings(groups["2025.10"]))
+	assert.Equal(t, []string{"2024.07.1"}, versionStrings(groups["2024.07"]))
+
+	groups = collection.GroupBySeries("major")
+	assert.Len(t, groups, 2)
+	assert.Equal(t, []string{"2025.07.3", "2025.10.0", "2025.07.4"}, versionStrings(groups["2025"]))
+}
+
+func TestCollectionLatestPerSeries(t *testing.T) {
+	tests := []struct {
+		name     string
+		level    string
+		versions []string
+		want     []string
+	}{
+		{
+			name:     "1",
+			level:    "minor",
+			versions: []string{"2025.07.3", "2025.10.0", "2025.07.4", "2025.10.1", "2025.08.0"},
+			want:     []string{"2025.07.4", "2025.08.0", "2025.10.1"},
+		},
+		{
+			name:     "2",
+			level:    "major",
+			versions: []string{"2025.07.3", "2024.10.0", "2025.01.4", "2024.12.1"},
+			want:     []string{"2024.12.1", "2025.07.3"},
+		},
+		{name: "3", level: "micro", versions: []string{"2025.07.3", "2025.07.3"}, want: []string{"2025.07.3"}},
+		{name: "4", level: "minor", versions: []string{}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collection, err := calver.NewCollection("<YYYY>.<0M>.<MICRO>", tt.versions...)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, versionStrings(collection.LatestPerSeries(tt.level)))
+		})
+	}
+}
+
+func TestCollectionDedupe(t *testing.T) {
+	tests := []struct {
+		name     string
+		versions []string
+		want     []string
+	}{
+		{name: "1", versions: []string{"2025.07.3", "2025.07.03", "2025.10.0"}, want: []string{"2025.07.3", "2025.10.0"}},
+		{name: "2", versions: []string{"2025.10.0", "2025.07.3", "2025.10.00", "2025.07.3"}, want: []string{"2025.10.0", "2025.07.3"}},
+		{name: "3", versions: []string{"2025.07.3-rc1", "2025.07.3", "2025.07.3-rc1"}, want: []string{"2025.07.3-rc1", "2025.07.3"}},
+		{name: "4", versions: []string{}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collection, err := calver.NewCollectionWithOptions(
+				tt.versions,
+				calver.WithFormat("<YYYY>.<0M>.<MICRO>", "<YYYY>.<0M>.<MICRO>-<MODIFIER>"),
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, versionStrings(collection.Dedupe()))
+			assert.Len(t, collection, len(tt.versions))
+		})
+	}
+}
+
+func TestCollectionClone(t *testing.T) {
+	collection, err := calver.NewCollection("<YYYY>.<0M>.<0D>.<MICRO>", "2025.07.14.3", "2025.10.01.0")
+	assert.NoError(t, err)
+
+	clone := collection.Clone()
+	assert.Equal(t, collection, clone)
+	for i := range clone {
+		assert.NotSame(t, collection[i], clone[i])
+	}
+	assert.NoError(t, clone[0].IncSegment(3, calver.BumpOptions{}))
+	assert.NoError(t, clone[1].IncMajor())
+	clone.SortWith()
+	assert.Equal(t, []string{"2025.07.14.3", "2025.10.01.0"}, versionStrings(collection))
+	assert.Equal(t, []string{"2025.07.14.4", "2026.10.01.0"}, versionStrings(clone))
+
+	assert.Nil(t, calver.Collection(nil).Clone())
+	assert.Equal(t, calver.Collection{}, calver.Collection{}.Clone())
+}
@@ -3,8 +3,54 @@ package calver
 import (
 	"strconv"
 	"strings"
+
+	"github.com/shazib-summar/go-calver/internal"
+)
+
+// ModifierOrder determines how the modifiers of two versions are compared.
+type ModifierOrder int
+
+const (
+	// ModifierLexical compares modifiers as integers if both are numbers and
+	// as strings otherwise. A version without a modifier is less than a version
+	// with a modifier. This is the default.
+	ModifierLexical ModifierOrder = iota
+	// ModifierSemVer compares modifiers using the SemVer 2.0 pre-release
+	// precedence rules: a version without a modifier is greater than a version
+	// with a modifier, modifiers are split into dot separated identifiers,
+	// numeric identifiers are compared as integers and are less than
+	// alphanumeric identifiers, which are compared as strings, and a shorter
+	// set of identifiers is less than a longer one if all preceding
+	// identifiers are equal. For example, 2025.07.14-alpha <
+	// 2025.07.14-alpha.1 < 2025.07.14-rc.2 < 2025.07.14-rc.10 < 2025.07.14.
+	ModifierSemVer
+	// ModifierNatural is like ModifierSemVer except that alphanumeric
+	// identifiers are compared naturally, i.e. runs of digits are compared as
+	// integers, so rc2 is less than rc10.
+	ModifierNatural
 )
 
+type compareOptions struct {
+	modifierOrder ModifierOrder
+}
+
+type compareOption func(*compareOptions)
+
+// WithModifierOrder is a compare option that sets how modifiers are compared.
+//
+// Example:
+//
+//	ver1, _ := calver.Parse("<YYYY>.<0M>.<0D>-<MODIFIER>", "2025.07.14-rc.1")
+//	ver2, _ := calver.Parse("<YYYY>.<0M>.<0D>", "2025.07.14")
+//	semver := calver.WithModifierOrder(calver.ModifierSemVer)
+//	fmt.Println(ver1.Compare(ver2))         // 1
+//	fmt.Println(ver1.Compare(ver2, semver)) // -1
+func WithModifierOrder(order ModifierOrder) compareOption {
+	return func(options *compareOptions) {
+		options.modifierOrder = order
+	}
+}
+
 // Compare returns 0 if the versions are equal, -1 if the current version is
 // less than the other version, and 1 if the current version is greater than the
 // other version.
@@ -23,29 +69,91 @@ import (
 //
 // The comparison is done in the following order: major, minor, micro, modifier.
 // Major, minor and micro are compared as integers whereas the modifier is
-// compared as integer if it is a number otherwise as a string.
-func (c *Version) Compare(v *Version) int {
-	res := compareStringInt(c.Major, v.Major)
-	if res != 0 {
-		return res
+// compared as integer if it is a number otherwise as a string. The way the
+// modifier is compared can be changed with the WithModifierOrder option. If a
+// format uses several conventions of a level, e.g. <0D> and <MICRO> in
+// <YYYY>.<0M>.<0D>.<MICRO>, they are compared in the order of Segments and a
+// missing segment is compared as an empty value.
+func (c *Version) Compare(v *Version, opts ...compareOption) int {
+	o := &compareOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return compareSegments(c, v, compareKeys(c, v), o)
+}
+
+// compareKeys returns the segments two versions are compared on: the first
+// segment of every level followed by as many other segments of the level as
+// the version with the most of them has.
+func compareKeys(a, b *Version) []segmentKey {
+	keys := make([]segmentKey, 0, len(internal.ValidLevels))
+	for _, lv := range internal.ValidLevels {
+		for rank := range max(a.levelSegments(lv), b.levelSegments(lv)) {
+			keys = append(keys, segmentKey{level: lv, rank: rank})
+		}
 	}
+	return keys
+}
 
-	res = compareStringInt(c.Minor, v.Minor)
-	if res != 0 {
-		return res
+// compareSegments compares the versions on the given segments only, in the
+// order they are given.
+func compareSegments(a, b *Version, keys []segmentKey, o *compareOptions) int {
+	for _, key := range keys {
+		av, bv := a.valueAt(key), b.valueAt(key)
+		var res int
+		if con := sharedConvention(a, b, key); con != nil && con.Compare != nil {
+			res = con.Compare(av, bv)
+		} else if key.level == internal.KeyModifier {
+			res = compareModifier(av, bv, o.modifierOrder)
+		} else {
+			res = compareStringInt(av, bv)
+		}
+		if res != 0 {
+			return res
+		}
 	}
+	return 0
+}
 
-	res = compareStringInt(c.Micro, v.Micro)
-	if res != 0 {
-		return res
+// sharedConvention returns the custom convention both versions use for the
+// segment or nil if they do not use the same one. Using the Compare function
+// of only one side would make a.Compare(b) and b.Compare(a) disagree.
+func sharedConvention(a, b *Version, key segmentKey) *internal.Convention {
+	ac, bc := a.customConvention(key), b.customConvention(key)
+	if ac == nil || bc == nil || ac.Name != bc.Name {
+		return nil
 	}
+	return ac
+}
 
-	res = compareStringInt(c.Modifier, v.Modifier)
-	if res != 0 {
-		return res
+// customConvention returns the custom convention the version uses for the
+// segment or nil if the segment uses a built-in convention. Only the compiled
+// format the version was parsed or created with is considered so that
+// comparisons do not compile formats.
+func (c *Version) customConvention(key segmentKey) *internal.Convention {
+	if c.format == nil || c.format.custom == nil || c.format.raw != c.Format {
+		return nil
+	}
+	i := c.format.segment(key)
+	if i < 0 {
+		return nil
 	}
+	if con := c.format.segments[i].convention; con.Kind == internal.KindCustom {
+		return con
+	}
+	return nil
+}
 
-	return 0
+// compareModifier compares two modifiers using the given order.
+func compareModifier(a, b string, order ModifierOrder) int {
+	switch order {
+	case ModifierSemVer:
+		return comparePreRelease(a, b, false)
+	case ModifierNatural:
+		return comparePreRelease(a, b, true)
+	}
+	return compareStringInt(a, b)
 }
 
 // Equal reports whether the version is equal to the other version.
@@ -159,3 +267,112 @@ func compareStringInt(a, b string) int {
 	}
 	return 0
 }
+
+// comparePreRelease compares two modifiers using the SemVer 2.0 pre-release
+// precedence rules. If natural is true, alphanumeric identifiers are compared
+// using compareNatural rather than as strings.
+func comparePreRelease(a, b string, natural bool) int {
+	if a == b {
+		return 0
+	}
+	if a == "" {
+		return 1
+	}
+	if b == "" {
+		return -1
+	}
+
+	aIDs := strings.Split(a, ".")
+	bIDs := strings.Split(b, ".")
+	for i := 0; i < len(aIDs) && i < len(bIDs); i++ {
+		if res := compareIdentifier(aIDs[i], bIDs[i], natural); res != 0 {
+			return res
+		}
+	}
+	switch {
+	case len(aIDs) < len(bIDs):
+		return -1
+	case len(aIDs) > len(bIDs):
+		return 1
+	}
+	return 0
+}
+
+// compareIdentifier compares two pre-release identifiers. Numeric identifiers
+// are less than alphanumeric identifiers.
+func compareIdentifier(a, b string, natural bool) int {
+	aNum, bNum := isNumeric(a), isNumeric(b)
+	switch {
+	case aNum && bNum:
+		return compareDigits(a, b)
+	case aNum:
+		return -1
+	case bNum:
+		return 1
+	case natural:
+		return compareNatural(a, b)
+	}
+	return strings.Compare(a, b)
+}
+
+// compareNatural compares two strings treating runs of digits as integers, so
+// rc2 is less than rc10.
+func compareNatural(a, b string) int {
+	for a != "" && b != "" {
+		aChunk, aDigits := nextChunk(a)
+		bChunk, bDigits := nextChunk(b)
+		var res int
+		if aDigits && bDigits {
+			res = compareDigits(aChunk, bChunk)
+		} else {
+			res = strings.Compare(aChunk, bChunk)
+		}
+		if res != 0 {
+			return res
+		}
+		a, b = a[len(aChunk):], b[len(bChunk):]
+	}
+	return strings.Compare(a, b)
+}
+
+// nextChunk returns the leading run of digits or non-digits of s and reports
+// whether it is made of digits.
+func nextChunk(s string) (string, bool) {
+	digits := isDigit(s[0])
+	i := 1
+	for i < len(s) && isDigit(s[i]) == digits {
+		i++
+	}
+	return s[:i], digits
+}
+
+// compareDigits compares two strings of digits of any length as integers.
+func compareDigits(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// isNumeric reports whether s is a non-empty string of ASCII digits.
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isDigit reports whether b is an ASCII digit.
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
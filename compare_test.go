@@ -384,3 +384,116 @@ func TestGreaterThanOrEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestCompareModifierOrder(t *testing.T) {
+	formats := []string{"<YYYY>.<0M>.<0D>", "<YYYY>.<0M>.<0D>-<MODIFIER>"}
+	tests := []struct {
+		name    string
+		order   calver.ModifierOrder
+		version string
+		other   string
+		want    int
+	}{
+		{name: "1", order: calver.ModifierLexical, version: "2025.07.14-rc1", other: "2025.07.14", want: 1},
+		{name: "2", order: calver.ModifierLexical, version: "2025.07.14-rc10", other: "2025.07.14-rc2", want: -1},
+		{name: "3", order: calver.ModifierSemVer, version: "2025.07.14-rc1", other: "2025.07.14", want: -1},
+		{name: "4", order: calver.ModifierSemVer, version: "2025.07.14", other: "2025.07.14-rc1", want: 1},
+		{name: "5", order: calver.ModifierSemVer, version: "2025.07.14-rc.2", other: "2025.07.14-rc.10", want: -1},
+		{name: "6", order: calver.ModifierSemVer, version: "2025.07.14-rc10", other: "2025.07.14-rc2", want: -1},
+		{name: "7", order: calver.ModifierSemVer, version: "2025.07.14-alpha", other: "2025.07.14-alpha.1", want: -1},
+		{name: "8", order: calver.ModifierSemVer, version: "2025.07.14-alpha.1", other: "2025.07.14-alpha.beta", want: -1},
+		{name: "9", order: calver.ModifierSemVer, version: "2025.07.14-alpha.beta", other: "2025.07.14-beta", want: -1},
+		{name: "10", order: calver.ModifierSemVer, version: "2025.07.14-beta.2", other: "2025.07.14-beta.11", want: -1},
+		{name: "11", order: calver.ModifierSemVer, version: "2025.07.14-beta.11", other: "2025.07.14-rc.1", want: -1},
+		{name: "12", order: calver.ModifierSemVer, version: "2025.07.14-rc.1", other: "2025.07.14-rc.1", want: 0},
+		{name: "13", order: calver.ModifierSemVer, version: "2025.07.14-rc.1", other: "2025.07.13", want: 1},
+		{name: "14", order: calver.ModifierNatural, version: "2025.07.14-rc10", other: "2025.07.14-rc2", want: 1},
+		{name: "15", order: calver.ModifierNatural, version: "2025.07.14-rc2", other: "2025.07.14", want: -1},
+		{name: "16", order: calver.ModifierNatural, version: "2025.07.14-rc2a", other: "2025.07.14-rc2b", want: -1},
+		{name: "17", order: calver.ModifierNatural, version: "2025.07.14-rc", other: "2025.07.14-rc1", want: -1},
+		{name: "18", order: calver.ModifierNatural, version: "2025.07.14-1", other: "2025.07.14-rc", want: -1},
+		{name: "19", order: calver.ModifierNatural, version: "2025.07.14-rc02", other: "2025.07.14-rc2", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ver, err := calver.ParseWithOptions(tt.version, calver.WithFormat(formats...))
+			assert.NoError(t, err)
+			other, err := calver.ParseWithOptions(tt.other, calver.WithFormat(formats...))
+			assert.NoError(t, err)
+			got := ver.Compare(other, calver.WithModifierOrder(tt.order))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCompareSegments(t *testing.T) {
+	formats := []string{
+		"<YYYY>.<0M>.<0D>",
+		"<YYYY>.<0M>.<0D>-<MODIFIER>",
+		"<YYYY>.<0M>.<0D>.<MICRO>",
+		"<YYYY>.<0M>.<0D>.<MICRO>-<MODIFIER>",
+	}
+	tests := []struct {
+		name    string
+		version string
+		other   string
+		want    int
+	}{
+		{name: "1", version: "2025.07.14.3", other: "2025.07.14.10", want: -1},
+		{name: "2", version: "2025.07.14.3", other: "2025.07.14.3", want: 0},
+		{name: "3", version: "2025.07.15.0", other: "2025.07.14.10", want: 1},
+		{name: "4", version: "2025.07.14", other: "2025.07.14.0", want: -1},
+		{name: "5", version: "2025.07.14.1", other: "2025.07.14", want: 1},
+		{name: "6", version: "2025.07.14.1-rc1", other: "2025.07.14.1", want: 1},
+		{name: "7", version: "2025.07.14.2-rc1", other: "2025.07.14.10-rc1", want: -1},
+		{name: "8", version: "2025.07.14-rc1", other: "2025.07.14.1", want: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ver, err := calver.ParseWithOptions(tt.version, calver.WithFormat(formats...))
+			assert.NoError(t, err)
+			other, err := calver.ParseWithOptions(tt.other, calver.WithFormat(formats...))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, ver.Compare(other))
+			assert.Equal(t, -tt.want, other.Compare(ver))
+		})
+	}
+}
+
+func TestCompareOptionalSections(t *testing.T) {
+	const optional = "<YYYY>.<0M>.<0D>[.<MICRO>][-<MODIFIER>]"
+	tests := []struct {
+		name        string
+		version     string
+		other       string
+		otherFormat string
+		want        int
+	}{
+		{name: "1", version: "2025.07.14", other: "2025.07.14", want: 0},
+		{name: "2", version: "2025.07.14", other: "2025.07.14.0", want: -1},
+		{name: "3", version: "2025.07.14.2", other: "2025.07.14.10", want: -1},
+		{name: "4", version: "2025.07.15", other: "2025.07.14.2", want: 1},
+		{name: "5", version: "2025.07.14-rc1", other: "2025.07.14", want: 1},
+		{name: "6", version: "2025.07.14-rc1", other: "2025.07.14.1", want: -1},
+		{name: "7", version: "2025.07.14", other: "2025.07.14", otherFormat: "<YYYY>.<0M>.<0D>", want: 0},
+		{name: "8", version: "2025.07.14.3", other: "2025.07.14.3", otherFormat: "<YYYY>.<0M>.<0D>.<MICRO>", want: 0},
+		{name: "9", version: "2025.07.14", other: "2025.07.14.0", otherFormat: "<YYYY>.<0M>.<0D>.<MICRO>", want: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			otherFormat := tt.otherFormat
+			if otherFormat == "" {
+				otherFormat = optional
+			}
+			ver, err := calver.Parse(optional, tt.version)
+			assert.NoError(t, err)
+			other, err := calver.Parse(otherFormat, tt.other)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, ver.Compare(other))
+			assert.Equal(t, -tt.want, other.Compare(ver))
+		})
+	}
+}
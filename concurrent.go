@@ -0,0 +1,103 @@
+package calver
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// parseChunkSize is the number of version strings a worker of
+// NewCollectionConcurrent parses at a time.
+const parseChunkSize = 256
+
+// WithWorkers is a parse option that sets the number of goroutines
+// NewCollectionConcurrent parses version strings with. If n is less than 1,
+// which is the default, runtime.GOMAXPROCS(0) goroutines are used. It has no
+// effect on the other functions.
+func WithWorkers(n int) parseOption {
+	return func(options *parseOptions) {
+		options.workers = n
+	}
+}
+
+// NewCollectionConcurrent is like NewCollectionWithOptions but parses the
+// version strings in parallel, which is faster for large lists such as the
+// tags of a registry. The formats are compiled once and shared by the workers.
+// The versions of the collection are in the order of the version strings.
+//
+// If several version strings cannot be parsed, the error of the first of them
+// is returned, as with NewCollectionWithOptions. Parsing stops early when a
+// version string cannot be parsed or when ctx is done, in which case the error
+// of ctx is returned.
+//
+// Example:
+//
+//	collection, err := calver.NewCollectionConcurrent(
+//	    ctx,
+//	    tags,
+//	    calver.WithFormat("<YYYY>.<0M>.<0D>", "<YYYY>.<0M>.<0D>-<MODIFIER>"),
+//	    calver.WithWorkers(8),
+//	)
+//	if err != nil {
+//	    return err
+//	}
+func NewCollectionConcurrent(ctx context.Context, versions []string, opts ...parseOption) (Collection, error) {
+	o, formats, err := newParseOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	workers := o.workers
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	chunks := (len(versions) + parseChunkSize - 1) / parseChunkSize
+	workers = min(workers, chunks)
+
+	collection := make(Collection, len(versions))
+	// Chunks are handed out in order and a failing worker only stops the
+	// workers from taking new chunks, so every version string before the
+	// first one that cannot be parsed is parsed and the error is the same
+	// as with NewCollectionWithOptions.
+	var next atomic.Int64
+	var failed atomic.Bool
+	var mu sync.Mutex
+	errIndex := len(versions)
+	var firstErr error
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for !failed.Load() && ctx.Err() == nil {
+				start := int(next.Add(1)-1) * parseChunkSize
+				if start >= len(versions) {
+					return
+				}
+				for i := start; i < min(start+parseChunkSize, len(versions)); i++ {
+					ver, err := parseFormats(versions[i], formats, o)
+					if err != nil {
+						mu.Lock()
+						if i < errIndex {
+							errIndex, firstErr = i, err
+						}
+						mu.Unlock()
+						failed.Store(true)
+						break
+					}
+					collection[i] = ver
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return collection, nil
+}
@@ -0,0 +1,107 @@
+package calver_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/shazib-summar/go-calver"
+	"github.com/stretchr/testify/assert"
+)
+
+// tags returns n distinct version strings of the formats <YYYY>.<0M>.<MICRO>
+// and <YYYY>.<0M>.<MICRO>-<MODIFIER>.
+func tags(n int) []string {
+	versions := make([]string, n)
+	for i := range versions {
+		versions[i] = fmt.Sprintf("%d.%02d.%d", 2000+i%25, 1+i%12, i)
+		if i%3 == 0 {
+			versions[i] += "-rc1"
+		}
+	}
+	return versions
+}
+
+var tagFormats = calver.WithFormat("<YYYY>.<0M>.<MICRO>", "<YYYY>.<0M>.<MICRO>-<MODIFIER>")
+
+func TestNewCollectionConcurrent(t *testing.T) {
+	tests := []struct {
+		name    string
+		count   int
+		workers int
+	}{
+		{name: "1", count: 0, workers: 4},
+		{name: "2", count: 10, workers: 4},
+		{name: "3", count: 5000, workers: 1},
+		{name: "4", count: 5000, workers: 4},
+		{name: "5", count: 5000, workers: 100},
+		{name: "6", count: 5000, workers: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versions := tags(tt.count)
+			collection, err := calver.NewCollectionConcurrent(
+				context.Background(),
+				versions,
+				tagFormats,
+				calver.WithWorkers(tt.workers),
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, versions, versionStrings(collection))
+		})
+	}
+}
+
+func TestNewCollectionConcurrentError(t *testing.T) {
+	versions := tags(5000)
+	versions[1234] = "latest"
+	versions[4321] = "nightly"
+
+	_, want := calver.NewCollectionWithOptions(versions, tagFormats)
+	assert.ErrorIs(t, want, calver.ErrMismatch)
+	for _, workers := range []int{1, 3, 16} {
+		collection, err := calver.NewCollectionConcurrent(
+			context.Background(),
+			versions,
+			tagFormats,
+			calver.WithWorkers(workers),
+		)
+		assert.Nil(t, collection)
+		assert.Equal(t, want, err)
+	}
+
+	_, err := calver.NewCollectionConcurrent(context.Background(), versions)
+	assert.ErrorIs(t, err, calver.ErrNoFormat)
+}
+
+func TestNewCollectionConcurrentCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	collection, err := calver.NewCollectionConcurrent(ctx, tags(5000), tagFormats)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, collection)
+}
+
+func BenchmarkNewCollectionWithOptions(b *testing.B) {
+	versions := tags(100_000)
+	for b.Loop() {
+		_, _ = calver.NewCollectionWithOptions(versions, tagFormats)
+	}
+}
+
+func BenchmarkNewCollectionConcurrent(b *testing.B) {
+	versions := tags(100_000)
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for b.Loop() {
+				_, _ = calver.NewCollectionConcurrent(
+					context.Background(),
+					versions,
+					tagFormats,
+					calver.WithWorkers(workers),
+				)
+			}
+		})
+	}
+}
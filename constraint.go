@@ -0,0 +1,244 @@
+package calver
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Constraint is a set of conditions a version may satisfy, such as
+// ">=2025.04, <2026.01". A Constraint is created with NewConstraint and is safe
+// for concurrent use by multiple goroutines.
+type Constraint struct {
+	raw  string
+	opts *compareOptions
+	// groups holds the conditions separated by "||". A version satisfies the
+	// constraint if it satisfies every condition of at least one group.
+	groups [][]*condition
+}
+
+// condition is a single comparison such as ">=2025.04".
+type condition struct {
+	op string
+	// version is nil if the condition matches any version, i.e. "*".
+	version *Version
+	// keys are the segments of version that are compared. Partial versions
+	// such as "2025.04" for the format <YYYY>.<0M>.<MICRO> and versions with
+	// absent optional sections only compare the segments they have.
+	keys []segmentKey
+}
+
+// NewConstraint parses a constraint expression for versions of the given
+// format. The expression is made of conditions each consisting of an operator
+// and a version:
+//
+//	=    equal (the default if no operator is given, == is also accepted)
+//	!=   not equal
+//	<    less than
+//	<=   less than or equal
+//	>    greater than
+//	>=   greater than or equal
+//
+// Conditions separated by a comma must all be satisfied, while groups of
+// conditions separated by "||" are alternatives. A hyphen range "A - B" is the
+// same as ">=A, <=B" and must have spaces around the hyphen.
+//
+// Versions in conditions may be partial, i.e. they may only contain the
+// leading levels of the format. A partial version only compares the levels it
+// has, so with the format <YYYY>.<0M>.<MICRO>, "=2025.07" matches every
+// version of July 2025 and "<2026.01" matches every version before January
+// 2026. A trailing wildcard is also accepted, so "2025.07.*" is the same as
+// "2025.07", and "*" matches any version, as does the literal prefix of the
+// format followed by a wildcard, e.g. "v*" with the format v<YYYY>.<0M>.
+//
+// Absent optional sections, see CompileFormat, leave the version partial too,
+// so with the format <YYYY>.<0M>.<0D>[.<MICRO>], "=2025.07.14" matches both
+// 2025.07.14 and 2025.07.14.2.
+//
+// Example:
+//
+//	c, err := calver.NewConstraint("<YYYY>.<0M>.<MICRO>", ">=2025.04, <2026.01")
+//	if err != nil {
+//	    return err
+//	}
+//	ver, err := calver.Parse("<YYYY>.<0M>.<MICRO>", "2025.07.3")
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(c.Check(ver)) // true
+//
+// Compare options, such as WithModifierOrder, are used when comparing versions
+// to the constraint.
+func NewConstraint(format, expr string, opts ...compareOption) (*Constraint, error) {
+	f, err := compileCached(format)
+	if err != nil {
+		return nil, err
+	}
+	formats, err := f.truncated()
+	if err != nil {
+		return nil, err
+	}
+
+	o := &compareOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	c := &Constraint{raw: expr, opts: o}
+	for _, group := range strings.Split(expr, "||") {
+		var conds []*condition
+		for _, term := range strings.Split(group, ",") {
+			term = strings.TrimSpace(term)
+			if term == "" {
+				return nil, fmt.Errorf("invalid constraint %q: empty condition", expr)
+			}
+			if lower, upper, ok := strings.Cut(term, " - "); ok {
+				low, err := parseCondition(">="+strings.TrimSpace(lower), formats)
+				if err != nil {
+					return nil, fmt.Errorf("invalid constraint %q: %w", expr, err)
+				}
+				high, err := parseCondition("<="+strings.TrimSpace(upper), formats)
+				if err != nil {
+					return nil, fmt.Errorf("invalid constraint %q: %w", expr, err)
+				}
+				conds = append(conds, low, high)
+				continue
+			}
+			cond, err := parseCondition(term, formats)
+			if err != nil {
+				return nil, fmt.Errorf("invalid constraint %q: %w", expr, err)
+			}
+			conds = append(conds, cond)
+		}
+		c.groups = append(c.groups, conds)
+	}
+	return c, nil
+}
+
+// parseCondition parses a single condition. The version of the condition is
+// parsed with the first of the formats it matches.
+func parseCondition(term string, formats []*Format) (*condition, error) {
+	cond := &condition{op: "="}
+	for _, op := range []string{"==", "!=", ">=", "<=", "=", ">", "<"} {
+		if strings.HasPrefix(term, op) {
+			cond.op = op
+			term = strings.TrimSpace(term[len(op):])
+			break
+		}
+	}
+	if cond.op == "==" {
+		cond.op = "="
+	}
+
+	// A trailing wildcard is the same as leaving out the levels it covers,
+	// along with the separator before it.
+	if trimmed, ok := strings.CutSuffix(term, "*"); ok {
+		if trimmed == "" || slices.ContainsFunc(formats, func(f *Format) bool {
+			return trimmed == f.leadingLiteral()
+		}) {
+			return cond, nil
+		}
+		term = trimmed
+		if r, size := utf8.DecodeLastRuneInString(term); !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			term = term[:len(term)-size]
+		}
+	}
+
+	var err error
+	for _, f := range formats {
+		cond.version, err = f.Parse(term)
+		if err == nil {
+			values := cond.version.segmentValues(f)
+			for i, seg := range f.segments {
+				if !f.absent(i, values) {
+					cond.keys = append(cond.keys, seg.key)
+				}
+			}
+			return cond, nil
+		}
+	}
+	return nil, fmt.Errorf("version %q does not match format %q", term, formats[0].raw)
+}
+
+// leadingLiteral returns the literal text before the first convention of the
+// format, e.g. "v" for v<YYYY>.<0M>.
+func (f *Format) leadingLiteral() string {
+	var out strings.Builder
+	for _, p := range f.parts {
+		if p.convention != nil || p.sectionStart {
+			break
+		}
+		out.WriteString(p.literal)
+	}
+	return out.String()
+}
+
+// String returns the constraint expression.
+func (c *Constraint) String() string {
+	return c.raw
+}
+
+// Check reports whether the version satisfies the constraint.
+func (c *Constraint) Check(v *Version) bool {
+	ok, _ := c.Validate(v)
+	return ok
+}
+
+// Validate reports whether the version satisfies the constraint. If it does
+// not, the returned errors describe every condition the version failed.
+//
+// Example:
+//
+//	c, _ := calver.NewConstraint("<YYYY>.<0M>.<MICRO>", ">=2025.04, <2026.01")
+//	ver, _ := calver.Parse("<YYYY>.<0M>.<MICRO>", "2026.02.0")
+//	ok, errs := c.Validate(ver)
+//	fmt.Println(ok)      // false
+//	fmt.Println(errs[0]) // 2026.02.0 is greater than or equal to 2026.01
+func (c *Constraint) Validate(v *Version) (bool, []error) {
+	var errs []error
+	for _, group := range c.groups {
+		var groupErrs []error
+		for _, cond := range group {
+			if err := cond.check(v, c.opts); err != nil {
+				groupErrs = append(groupErrs, err)
+			}
+		}
+		if len(groupErrs) == 0 {
+			return true, nil
+		}
+		errs = append(errs, groupErrs...)
+	}
+	return false, errs
+}
+
+// check returns an error describing why the version does not satisfy the
+// condition or nil if it does.
+func (cond *condition) check(v *Version, o *compareOptions) error {
+	if cond.version == nil {
+		return nil
+	}
+	res := compareSegments(v, cond.version, cond.keys, o)
+
+	var ok bool
+	var reason string
+	switch cond.op {
+	case "!=":
+		ok, reason = res != 0, "is equal to"
+	case ">":
+		ok, reason = res > 0, "is less than or equal to"
+	case ">=":
+		ok, reason = res >= 0, "is less than"
+	case "<":
+		ok, reason = res < 0, "is greater than or equal to"
+	case "<=":
+		ok, reason = res <= 0, "is greater than"
+	default:
+		ok, reason = res == 0, "is not equal to"
+	}
+	if ok {
+		return nil
+	}
+	return fmt.Errorf("%s %s %s", v.String(), reason, cond.version.String())
+}
@@ -0,0 +1,118 @@
+package calver_test
+
+import (
+	"testing"
+
+	"github.com/shazib-summar/go-calver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstraintCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		expr    string
+		version string
+		want    bool
+	}{
+		{name: "1", format: "<YYYY>.<0M>.<MICRO>", expr: ">=2025.04, <2026.01", version: "2025.07.3", want: true},
+		{name: "2", format: "<YYYY>.<0M>.<MICRO>", expr: ">=2025.04, <2026.01", version: "2025.04.0", want: true},
+		{name: "3", format: "<YYYY>.<0M>.<MICRO>", expr: ">=2025.04, <2026.01", version: "2025.03.9", want: false},
+		{name: "4", format: "<YYYY>.<0M>.<MICRO>", expr: ">=2025.04, <2026.01", version: "2026.01.0", want: false},
+		{name: "5", format: "<YYYY>.<0M>.<MICRO>", expr: "2025.07.*", version: "2025.07.3", want: true},
+		{name: "6", format: "<YYYY>.<0M>.<MICRO>", expr: "2025.07.*", version: "2025.08.0", want: false},
+		{name: "7", format: "<YYYY>.<0M>.<MICRO>", expr: "!=2025.07.*", version: "2025.08.0", want: true},
+		{name: "8", format: "<YYYY>.<0M>.<MICRO>", expr: "=2025.07.3", version: "2025.07.3", want: true},
+		{name: "9", format: "<YYYY>.<0M>.<MICRO>", expr: "==2025.07.3", version: "2025.07.4", want: false},
+		{name: "10", format: "<YYYY>.<0M>.<MICRO>", expr: "!=2025.07.3", version: "2025.07.4", want: true},
+		{name: "11", format: "<YYYY>.<0M>.<MICRO>", expr: "> 2025.07.3", version: "2025.07.4", want: true},
+		{name: "12", format: "<YYYY>.<0M>.<MICRO>", expr: ">2025.07", version: "2025.07.4", want: false},
+		{name: "13", format: "<YYYY>.<0M>.<MICRO>", expr: "<=2025.07", version: "2025.07.4", want: true},
+		{name: "14", format: "<YYYY>.<0M>.<MICRO>", expr: "<2025.07 || >=2025.09", version: "2025.08.1", want: false},
+		{name: "15", format: "<YYYY>.<0M>.<MICRO>", expr: "<2025.07 || >=2025.09", version: "2025.10.1", want: true},
+		{name: "16", format: "<YYYY>.<0M>.<MICRO>", expr: "2025.03 - 2025.06", version: "2025.06.9", want: true},
+		{name: "17", format: "<YYYY>.<0M>.<MICRO>", expr: "2025.03 - 2025.06", version: "2025.07.0", want: false},
+		{name: "18", format: "<YYYY>.<0M>.<MICRO>", expr: "*", version: "2025.07.0", want: true},
+		{name: "19", format: "<YYYY>.<0M>.<MICRO>", expr: "2025.*", version: "2025.07.0", want: true},
+		{name: "20", format: "<YYYY>.<0M>.<MICRO>", expr: "2025", version: "2024.07.0", want: false},
+		{name: "21", format: "Rel-<YYYY>-<0M>-<0D>", expr: ">=Rel-2025-07, <Rel-2025-08", version: "Rel-2025-07-14", want: true},
+		{name: "22", format: "Rel-<YYYY>-<0M>-<0D>", expr: "Rel-2025-07-*", version: "Rel-2025-07-14", want: true},
+		{name: "23", format: "<YYYY>.<0M>.<0D>-<MODIFIER>", expr: ">2025.07.14-rc1", version: "2025.07.14-rc2", want: true},
+		{name: "24", format: "<YYYY>.<0M>.<0D>.<MICRO>", expr: "2025.07.14.*", version: "2025.07.14.3", want: true},
+		{name: "25", format: "<YYYY>.<0M>.<0D>.<MICRO>", expr: ">=2025.07.14.4", version: "2025.07.14.3", want: false},
+		{name: "26", format: "<YYYY>.<0M>.<0D>.<MICRO>", expr: "<2025.07.15", version: "2025.07.14.30", want: true},
+		{name: "27", format: "<YYYY>.<0M>.<0D>[.<MICRO>]", expr: "2025.07.14", version: "2025.07.14.2", want: true},
+		{name: "28", format: "<YYYY>.<0M>.<0D>[.<MICRO>]", expr: "2025.07.14.*", version: "2025.07.14", want: true},
+		{name: "29", format: "<YYYY>.<0M>.<0D>[.<MICRO>]", expr: ">=2025.07.14.1", version: "2025.07.14", want: false},
+		{name: "30", format: "<YYYY>.<0M>.<0D>[.<MICRO>]", expr: ">=2025.07, <2025.07.14.2", version: "2025.07.14.1", want: true},
+		{name: "31", format: "<YYYY>[.<0M>[.<0D>]]", expr: "<2025.07.14", version: "2025.07", want: true},
+		{name: "32", format: "v<MAJOR>.<MINOR>", expr: "v*", version: "v3.1", want: true},
+		{name: "33", format: "v<MAJOR>.<MINOR>", expr: "v3.*", version: "v3.1", want: true},
+		{name: "34", format: "v<MAJOR>.<MINOR>", expr: "v3.*", version: "v4.0", want: false},
+		{name: "35", format: "Rel-<YYYY>-<0M>-<0D>", expr: "Rel-*", version: "Rel-2025-07-14", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := calver.NewConstraint(tt.format, tt.expr)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expr, c.String())
+			ver, err := calver.Parse(tt.format, tt.version)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, c.Check(ver))
+		})
+	}
+}
+
+func TestConstraintValidate(t *testing.T) {
+	c, err := calver.NewConstraint("<YYYY>.<0M>.<MICRO>", ">=2025.04, <2026.01 || 2026.03.*")
+	assert.NoError(t, err)
+
+	ver, err := calver.Parse("<YYYY>.<0M>.<MICRO>", "2026.02.0")
+	assert.NoError(t, err)
+	ok, errs := c.Validate(ver)
+	assert.False(t, ok)
+	assert.Len(t, errs, 2)
+	assert.EqualError(t, errs[0], "2026.02.0 is greater than or equal to 2026.01")
+	assert.EqualError(t, errs[1], "2026.02.0 is not equal to 2026.03")
+
+	ver, err = calver.Parse("<YYYY>.<0M>.<MICRO>", "2026.03.4")
+	assert.NoError(t, err)
+	ok, errs = c.Validate(ver)
+	assert.True(t, ok)
+	assert.Empty(t, errs)
+}
+
+func TestConstraintModifierOrder(t *testing.T) {
+	c, err := calver.NewConstraint(
+		"<YYYY>.<0M>.<0D>-<MODIFIER>",
+		"<2025.07.14-rc.2",
+		calver.WithModifierOrder(calver.ModifierSemVer),
+	)
+	assert.NoError(t, err)
+	ver, err := calver.Parse("<YYYY>.<0M>.<0D>-<MODIFIER>", "2025.07.14-rc.10")
+	assert.NoError(t, err)
+	assert.False(t, c.Check(ver))
+}
+
+func TestNewConstraintError(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		expr   string
+	}{
+		{name: "1", format: "<YYYY>.<0M>.<MICRO>", expr: ""},
+		{name: "2", format: "<YYYY>.<0M>.<MICRO>", expr: ">=2025.04,"},
+		{name: "3", format: "<YYYY>.<0M>.<MICRO>", expr: ">=2025-04"},
+		{name: "4", format: "<YYYY>.<0M>.<MICRO>", expr: "2025.04 - "},
+		{name: "5", format: "<YYYY>.<YYYY>", expr: "2025"},
+		{name: "6", format: "<YYYY>.<0M>.<MICRO>", expr: "~2025.04"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := calver.NewConstraint(tt.format, tt.expr)
+			assert.Error(t, err)
+		})
+	}
+}
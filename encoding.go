@@ -0,0 +1,187 @@
+package calver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+var (
+	defaultFormatsMu sync.RWMutex
+	defaultFormats   []*Format
+)
+
+// SetDefaultFormats sets the formats used to unmarshal a Version whose Format
+// field is empty, e.g. when decoding JSON, YAML or TOML into a *Version field.
+// Calling it again replaces the previously set formats. It returns an error if
+// any of the formats is invalid, in which case the default formats are left
+// unchanged.
+//
+// Example:
+//
+//	err := calver.SetDefaultFormats("<YYYY>.<0M>.<0D>", "<YYYY>.<0M>.<0D>-<MODIFIER>")
+//	if err != nil {
+//	    return err
+//	}
+//	var config struct {
+//	    Release *calver.Version `json:"release"`
+//	}
+//	err = json.Unmarshal([]byte(`{"release": "2025.07.14"}`), &config)
+func SetDefaultFormats(formats ...string) error {
+	compiled, err := compileFormats(formats)
+	if err != nil {
+		return err
+	}
+	defaultFormatsMu.Lock()
+	defer defaultFormatsMu.Unlock()
+	defaultFormats = compiled
+	return nil
+}
+
+// DefaultFormats returns the formats set with SetDefaultFormats.
+func DefaultFormats() []string {
+	defaultFormatsMu.RLock()
+	defer defaultFormatsMu.RUnlock()
+	formats := make([]string, 0, len(defaultFormats))
+	for _, f := range defaultFormats {
+		formats = append(formats, f.raw)
+	}
+	return formats
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. The version is
+// encoded using its String method. It has a value receiver so that Version
+// fields are encoded as text even when they are not addressable.
+func (c Version) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. If the
+// Format field of the version is set, it is used to parse the text. Otherwise
+// the formats set with SetDefaultFormats are used. A version previously parsed
+// with a Registry keeps using the registry as long as its Format is unchanged.
+//
+// It returns an error wrapping ErrNoFormat if neither is set.
+func (c *Version) UnmarshalText(text []byte) error {
+	var opt parseOption
+	switch {
+	case c.format != nil && c.format.raw == c.Format:
+		opt = WithCompiledFormat(c.format)
+	case c.Format != "":
+		opt = WithFormat(c.Format)
+	default:
+		defaultFormatsMu.RLock()
+		opt = WithCompiledFormat(defaultFormats...)
+		defaultFormatsMu.RUnlock()
+	}
+
+	ver, err := ParseWithOptions(string(text), opt)
+	if err != nil {
+		return err
+	}
+	*c = *ver
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. The version is encoded
+// as a JSON string. Like MarshalText, it has a value receiver.
+func (c Version) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. The version is
+// expected to be a JSON string and is parsed like in UnmarshalText. A JSON null
+// leaves the version unchanged.
+func (c *Version) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("version must be a JSON string: %w", err)
+	}
+	return c.UnmarshalText([]byte(s))
+}
+
+// FormatProvider provides the formats used to unmarshal a Typed version.
+type FormatProvider interface {
+	Formats() []string
+}
+
+// Typed is a Version bound to the formats provided by F. It can be used in
+// structs that are decoded from JSON, YAML, TOML or any other encoding relying
+// on encoding.TextUnmarshaler, without setting package level default formats.
+//
+// Example:
+//
+//	type UbuntuFormat struct{}
+//
+//	func (UbuntuFormat) Formats() []string {
+//	    return []string{"<0Y>.<0M>", "<0Y>.<0M>.<MICRO>"}
+//	}
+//
+//	var config struct {
+//	    Release calver.Typed[UbuntuFormat] `json:"release"`
+//	}
+//	err := json.Unmarshal([]byte(`{"release": "22.04.6"}`), &config)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(config.Release.Micro) // 6
+type Typed[F FormatProvider] struct {
+	*Version
+}
+
+// String returns the version string or an empty string if the version is
+// nil, e.g. for the zero Typed.
+func (t Typed[F]) String() string {
+	if t.Version == nil {
+		return ""
+	}
+	return t.Version.String()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. A nil version
+// is encoded as an empty string.
+func (t Typed[F]) MarshalText() ([]byte, error) {
+	if t.Version == nil {
+		return []byte{}, nil
+	}
+	return t.Version.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The text is
+// parsed using the formats provided by F.
+func (t *Typed[F]) UnmarshalText(text []byte) error {
+	var provider F
+	ver, err := ParseWithOptions(string(text), WithFormat(provider.Formats()...))
+	if err != nil {
+		return err
+	}
+	t.Version = ver
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. A nil version is
+// encoded as null.
+func (t Typed[F]) MarshalJSON() ([]byte, error) {
+	if t.Version == nil {
+		return []byte("null"), nil
+	}
+	return t.Version.MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. A JSON null sets the
+// version to nil.
+func (t *Typed[F]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		t.Version = nil
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("version must be a JSON string: %w", err)
+	}
+	return t.UnmarshalText([]byte(s))
+}
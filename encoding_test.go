@@ -0,0 +1,129 @@
+package calver_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shazib-summar/go-calver"
+	"github.com/stretchr/testify/assert"
+)
+
+type ubuntuFormat struct{}
+
+func (ubuntuFormat) Formats() []string {
+	return []string{"<0Y>.<0M>", "<0Y>.<0M>.<MICRO>"}
+}
+
+func TestVersionMarshalJSON(t *testing.T) {
+	ver, err := calver.Parse("Rel-<YYYY>-<0M>-<0D>", "Rel-2025-07-14")
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(struct {
+		Release *calver.Version `json:"release"`
+	}{Release: ver})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"release": "Rel-2025-07-14"}`, string(data))
+
+	text, err := ver.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "Rel-2025-07-14", string(text))
+
+	// A Version field of a struct passed by value is not addressable.
+	data, err = json.Marshal(struct {
+		Release calver.Version `json:"release"`
+	}{Release: *ver})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"release": "Rel-2025-07-14"}`, string(data))
+
+	data, err = json.Marshal(map[string]calver.Version{"release": *ver})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"release": "Rel-2025-07-14"}`, string(data))
+
+	data, err = json.Marshal(struct {
+		Release *calver.Version `json:"release"`
+	}{})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"release": null}`, string(data))
+}
+
+func TestVersionUnmarshalJSON(t *testing.T) {
+	t.Cleanup(func() { assert.NoError(t, calver.SetDefaultFormats()) })
+
+	var config struct {
+		Release *calver.Version `json:"release"`
+	}
+	err := json.Unmarshal([]byte(`{"release": "2025.07.14"}`), &config)
+	assert.ErrorIs(t, err, calver.ErrNoFormat)
+
+	assert.Error(t, calver.SetDefaultFormats("<YYYY>.<YYYY>"))
+	assert.NoError(t, calver.SetDefaultFormats("<YYYY>.<0M>.<0D>", "<YYYY>.<0M>.<0D>-<MODIFIER>"))
+	assert.Equal(t, []string{"<YYYY>.<0M>.<0D>", "<YYYY>.<0M>.<0D>-<MODIFIER>"}, calver.DefaultFormats())
+
+	config.Release = nil
+	err = json.Unmarshal([]byte(`{"release": "2025.07.14-rc1"}`), &config)
+	assert.NoError(t, err)
+	assert.Equal(t, "2025.07.14-rc1", config.Release.String())
+	assert.Equal(t, "rc1", config.Release.Modifier)
+
+	err = json.Unmarshal([]byte(`{"release": "2025-07-14"}`), &config)
+	assert.ErrorIs(t, err, calver.ErrMismatch)
+
+	err = json.Unmarshal([]byte(`{"release": 2025}`), &config)
+	assert.Error(t, err)
+
+	config.Release = nil
+	err = json.Unmarshal([]byte(`{"release": null}`), &config)
+	assert.NoError(t, err)
+	assert.Nil(t, config.Release)
+}
+
+func TestVersionUnmarshalTextWithFormat(t *testing.T) {
+	ver := &calver.Version{Format: "v<MAJOR>.<MINOR>"}
+	assert.NoError(t, ver.UnmarshalText([]byte("v1.2")))
+	assert.Equal(t, "1", ver.Major)
+	assert.Equal(t, "2", ver.Minor)
+	assert.Equal(t, "v1.2", ver.String())
+
+	assert.Error(t, ver.UnmarshalText([]byte("1.2")))
+}
+
+func TestTyped(t *testing.T) {
+	type config struct {
+		Release calver.Typed[ubuntuFormat]  `json:"release"`
+		Other   *calver.Typed[ubuntuFormat] `json:"other"`
+	}
+
+	var c config
+	err := json.Unmarshal([]byte(`{"release": "22.04.6", "other": "24.10"}`), &c)
+	assert.NoError(t, err)
+	assert.Equal(t, "22", c.Release.Major)
+	assert.Equal(t, "6", c.Release.Micro)
+	assert.Equal(t, "24.10", c.Other.String())
+
+	data, err := json.Marshal(c)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"release": "22.04.6", "other": "24.10"}`, string(data))
+
+	err = json.Unmarshal([]byte(`{"release": "2022.04.6"}`), &c)
+	assert.ErrorIs(t, err, calver.ErrMismatch)
+
+	data, err = json.Marshal(config{})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"release": null, "other": null}`, string(data))
+
+	var typed calver.Typed[ubuntuFormat]
+	assert.NotPanics(t, func() {
+		assert.Equal(t, "", typed.String())
+		text, err := typed.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, "", string(text))
+		data, err := typed.MarshalJSON()
+		assert.NoError(t, err)
+		assert.Equal(t, "null", string(data))
+	})
+	assert.NoError(t, typed.UnmarshalText([]byte("22.04")))
+	assert.Equal(t, "22.04", typed.String())
+	text, err := typed.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "22.04", string(text))
+}
@@ -0,0 +1,199 @@
+package calver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrNoFormat is returned when no format is provided to parse a version
+	// string.
+	ErrNoFormat = errors.New("no format provided")
+	// ErrInvalidFormat is returned when a format string is malformed. The
+	// returned error is a *FormatError.
+	ErrInvalidFormat = errors.New("invalid format")
+	// ErrMismatch is returned when a version string does not match the format.
+	// The returned error is a *MismatchError.
+	ErrMismatch = errors.New("version does not match format")
+	// ErrNoLevels is returned when a version string matches the format but no
+	// level was captured, for example when the format is "v<MODIFIER>" and the
+	// version is "v".
+	ErrNoLevels = errors.New("no levels captured")
+	// ErrInvalidCalendar is returned when a version string contains a calendar
+	// value that is not a valid date and strict calendar validation is enabled.
+	// The returned error is a *CalendarError.
+	ErrInvalidCalendar = errors.New("invalid calendar value")
+	// ErrNoYear is returned when a date is requested from a version whose
+	// format has no year convention, i.e. <YYYY>, <YY> or <0Y>.
+	ErrNoYear = errors.New("format has no year convention")
+	// ErrNoCounter is returned by Version.Next when the calendar levels of the
+	// version are unchanged and the format has no counter, i.e. <MAJOR>,
+	// <MINOR>, <MICRO> or a numeric <MODIFIER>, that can be incremented.
+	ErrNoCounter = errors.New("format has no counter to increment")
+	// ErrNoModifier is returned by Version.Next when the format has a
+	// modifier that is neither numeric nor in an optional section, has no
+	// initial value, see CustomConvention, and no modifier is given with
+	// WithNextModifier.
+	ErrNoModifier = errors.New("format requires a modifier")
+	// ErrClockBehind is returned by Version.Next when the calendar levels of
+	// the version are later than the given time.
+	ErrClockBehind = errors.New("version is later than the given time")
+	// ErrInvalidConvention is returned by Registry.Register when a custom
+	// convention is malformed or clashes with another convention, and when
+	// the Increment function of a custom convention returns a value that does
+	// not match its regex.
+	ErrInvalidConvention = errors.New("invalid convention")
+	// ErrAmbiguous is returned when a version string matches several formats
+	// and the ResolveErrorOnAmbiguity resolution is used. The returned error
+	// is an *AmbiguityError.
+	ErrAmbiguous = errors.New("version matches several formats")
+	// ErrTooManyRejections is returned by NewCollectionLenient when more
+	// version strings are rejected than allowed by WithRejectLimit.
+	ErrTooManyRejections = errors.New("too many versions rejected")
+)
+
+// FormatError is returned when a format string is malformed. It can be matched
+// with errors.Is(err, ErrInvalidFormat).
+//
+// Example:
+//
+//	_, err := calver.CompileFormat("<YYYY>.<0Y>")
+//	var fe *calver.FormatError
+//	if errors.As(err, &fe) {
+//	    fmt.Println(fe.Offset) // 7
+//	}
+type FormatError struct {
+	// Format is the malformed format string.
+	Format string
+	// Offset is the byte offset in the format string at which the problem was
+	// found or -1 if the problem concerns the format string as a whole.
+	Offset int
+	// Reason describes the problem.
+	Reason string
+}
+
+func (e *FormatError) Error() string {
+	if e.Offset < 0 {
+		return fmt.Sprintf("invalid format %q: %s", e.Format, e.Reason)
+	}
+	return fmt.Sprintf("invalid format %q: %s at offset %d", e.Format, e.Reason, e.Offset)
+}
+
+// Is reports whether the target is ErrInvalidFormat.
+func (e *FormatError) Is(target error) bool {
+	return target == ErrInvalidFormat
+}
+
+// MismatchError is returned when a version string does not match a format. It
+// can be matched with errors.Is(err, ErrMismatch).
+//
+// When multiple formats are provided, the error describes the format that
+// matched the longest prefix of the version string.
+//
+// Example:
+//
+//	_, err := calver.Parse("<YYYY>.<0M>.<MICRO>", "2025.7.3")
+//	var me *calver.MismatchError
+//	if errors.As(err, &me) {
+//	    fmt.Println(me.Diagnostic())
+//	    // 2025.7.3
+//	    //      ^ expected <0M>
+//	}
+type MismatchError struct {
+	// Version is the version string that failed to match.
+	Version string
+	// Format is the format string that was tried.
+	Format string
+	// Offset is the byte offset in the version string at which matching
+	// failed.
+	Offset int
+	// Expected is the convention, e.g. <0M>, or the quoted literal text, e.g.
+	// ".", that was expected at Offset. It is "end of version" when the
+	// version string has trailing characters.
+	Expected string
+}
+
+func (e *MismatchError) Error() string {
+	return fmt.Sprintf(
+		"version %q does not match format %q: expected %s at offset %d",
+		e.Version, e.Format, e.Expected, e.Offset,
+	)
+}
+
+// Is reports whether the target is ErrMismatch.
+func (e *MismatchError) Is(target error) bool {
+	return target == ErrMismatch
+}
+
+// Diagnostic returns the version string followed by a line with a caret under
+// the offset at which matching failed.
+func (e *MismatchError) Diagnostic() string {
+	return fmt.Sprintf(
+		"%s\n%s^ expected %s",
+		e.Version,
+		strings.Repeat(" ", e.Offset),
+		e.Expected,
+	)
+}
+
+// CalendarError is returned when a calendar value of a version string is not a
+// valid date, for example month 13 or February 30. It is only returned when the
+// WithStrictCalendar parse option is used, or by Format.FromTime when a date
+// cannot be represented by the format, and can be matched with
+// errors.Is(err, ErrInvalidCalendar).
+type CalendarError struct {
+	// Version is the version string containing the invalid value.
+	Version string
+	// Format is the format string that matched the version string.
+	Format string
+	// Convention is the convention of the invalid value e.g. <0M>.
+	Convention string
+	// Value is the invalid value.
+	Value string
+	// Reason describes why the value is invalid.
+	Reason string
+}
+
+func (e *CalendarError) Error() string {
+	return fmt.Sprintf(
+		"version %q has invalid %s value %q: %s",
+		e.Version, e.Convention, e.Value, e.Reason,
+	)
+}
+
+// Is reports whether the target is ErrInvalidCalendar.
+func (e *CalendarError) Is(target error) bool {
+	return target == ErrInvalidCalendar
+}
+
+// AmbiguityError is returned when a version string matches several formats and
+// the ResolveErrorOnAmbiguity resolution is used, see WithResolution. It can
+// be matched with errors.Is(err, ErrAmbiguous).
+//
+// Example:
+//
+//	_, err := calver.ParseWithOptions(
+//	    "25.07",
+//	    calver.WithFormat("<YY>.<0M>", "<0Y>.<0M>"),
+//	    calver.WithResolution(calver.ResolveErrorOnAmbiguity),
+//	)
+//	var ae *calver.AmbiguityError
+//	if errors.As(err, &ae) {
+//	    fmt.Println(ae.Formats) // [<YY>.<0M> <0Y>.<0M>]
+//	}
+type AmbiguityError struct {
+	// Version is the version string matching several formats.
+	Version string
+	// Formats holds the matching format strings in the order they were given.
+	Formats []string
+}
+
+func (e *AmbiguityError) Error() string {
+	return fmt.Sprintf("version %q matches several formats: %q", e.Version, e.Formats)
+}
+
+// Is reports whether the target is ErrAmbiguous.
+func (e *AmbiguityError) Is(target error) bool {
+	return target == ErrAmbiguous
+}
@@ -0,0 +1,142 @@
+package calver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shazib-summar/go-calver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatError(t *testing.T) {
+	tests := []struct {
+		name       string
+		format     string
+		wantOffset int
+	}{
+		{name: "1", format: "", wantOffset: -1},
+		{name: "2", format: "foobar", wantOffset: -1},
+		{name: "3", format: "<YYYY>.<0Y>", wantOffset: 7},
+		{name: "4", format: "v<0M>.<MICRO>-<MM>", wantOffset: 14},
+		{name: "5", format: "<YYYY>[.<0M>", wantOffset: 6},
+		{name: "6", format: "<YYYY>.<0M>]", wantOffset: 11},
+		{name: "7", format: "<YYYY>[.]<0M>", wantOffset: 6},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := calver.CompileFormat(test.format)
+			assert.ErrorIs(t, err, calver.ErrInvalidFormat)
+			assert.NotErrorIs(t, err, calver.ErrMismatch)
+
+			var fe *calver.FormatError
+			assert.True(t, errors.As(err, &fe))
+			assert.Equal(t, test.format, fe.Format)
+			assert.Equal(t, test.wantOffset, fe.Offset)
+
+			_, err = calver.Parse(test.format, "2025")
+			assert.ErrorIs(t, err, calver.ErrInvalidFormat)
+		})
+	}
+}
+
+func TestMismatchError(t *testing.T) {
+	tests := []struct {
+		name         string
+		formats      []string
+		version      string
+		wantFormat   string
+		wantOffset   int
+		wantExpected string
+	}{
+		{
+			name:         "1",
+			formats:      []string{"<YYYY>.<0M>.<MICRO>"},
+			version:      "2025.7.3",
+			wantFormat:   "<YYYY>.<0M>.<MICRO>",
+			wantOffset:   5,
+			wantExpected: "<0M>",
+		},
+		{
+			name:         "2",
+			formats:      []string{"<YYYY>.<0M>.<MICRO>"},
+			version:      "2025-07.3",
+			wantFormat:   "<YYYY>.<0M>.<MICRO>",
+			wantOffset:   4,
+			wantExpected: `"."`,
+		},
+		{
+			name:         "3",
+			formats:      []string{"<YYYY>.<0M>"},
+			version:      "2025.07.3",
+			wantFormat:   "<YYYY>.<0M>",
+			wantOffset:   7,
+			wantExpected: "end of version",
+		},
+		{
+			name:         "4",
+			formats:      []string{"Rel-<YYYY>"},
+			version:      "2025",
+			wantFormat:   "Rel-<YYYY>",
+			wantOffset:   0,
+			wantExpected: `"Rel-"`,
+		},
+		{
+			name:         "5",
+			formats:      []string{"Rel-<YYYY>", "<YYYY>.<0M>.<0D>"},
+			version:      "2025.07.x",
+			wantFormat:   "<YYYY>.<0M>.<0D>",
+			wantOffset:   8,
+			wantExpected: "<0D>",
+		},
+		{
+			name:         "6",
+			formats:      []string{"<YYYY>.<0M>.<0D>[.<MICRO>]"},
+			version:      "2025.07.14.x",
+			wantFormat:   "<YYYY>.<0M>.<0D>[.<MICRO>]",
+			wantOffset:   11,
+			wantExpected: "<MICRO>",
+		},
+		{
+			name:         "7",
+			formats:      []string{"<YYYY>.<0M>.<0D>[.<MICRO>]"},
+			version:      "2025.07.14-2",
+			wantFormat:   "<YYYY>.<0M>.<0D>[.<MICRO>]",
+			wantOffset:   10,
+			wantExpected: `"."`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := calver.ParseWithOptions(test.version, calver.WithFormat(test.formats...))
+			assert.ErrorIs(t, err, calver.ErrMismatch)
+			assert.NotErrorIs(t, err, calver.ErrInvalidFormat)
+
+			var me *calver.MismatchError
+			assert.True(t, errors.As(err, &me))
+			assert.Equal(t, test.version, me.Version)
+			assert.Equal(t, test.wantFormat, me.Format)
+			assert.Equal(t, test.wantOffset, me.Offset)
+			assert.Equal(t, test.wantExpected, me.Expected)
+		})
+	}
+}
+
+func TestMismatchErrorDiagnostic(t *testing.T) {
+	_, err := calver.Parse("<YYYY>.<0M>.<MICRO>", "2025.7.3")
+	var me *calver.MismatchError
+	assert.True(t, errors.As(err, &me))
+	assert.Equal(t, "2025.7.3\n     ^ expected <0M>", me.Diagnostic())
+}
+
+func TestSentinelErrors(t *testing.T) {
+	_, err := calver.ParseWithOptions("2025")
+	assert.ErrorIs(t, err, calver.ErrNoFormat)
+
+	_, err = calver.ParseWithOptions("2025", calver.WithFormat())
+	assert.ErrorIs(t, err, calver.ErrNoFormat)
+
+	_, err = calver.Parse("v<MODIFIER>", "v")
+	assert.ErrorIs(t, err, calver.ErrNoLevels)
+}
@@ -0,0 +1,653 @@
+package calver
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/shazib-summar/go-calver/internal"
+)
+
+// Format is a compiled format string. It holds the compiled regex along with
+// the position and metadata of every convention used in the format so that it
+// can be reused to parse any number of version strings without recompiling.
+//
+// A Format is safe for concurrent use by multiple goroutines.
+//
+// Example:
+//
+//	f, err := calver.CompileFormat("<YYYY>.<0M>.<MICRO>")
+//	if err != nil {
+//	    return err
+//	}
+//	ver, err := f.Parse("2025.07.3")
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(ver.String()) // 2025.07.3
+type Format struct {
+	raw string
+	re  *regexp.Regexp
+	// parts is the format string split into literal text, conventions and the
+	// brackets of optional sections, in the order they appear in the format
+	// string.
+	parts []formatPart
+	// segments holds the conventions of the format from the most to the least
+	// significant, i.e. ordered by level and, within a level, by position in
+	// the format string.
+	segments []formatSegment
+	// levels maps each level used in the format to the index of its first
+	// segment.
+	levels map[string]int
+	// custom holds the custom conventions the format was compiled with, see
+	// Registry.
+	custom map[string]internal.Convention
+
+	// prefixes holds, for every part, a regex matching the format up to and
+	// including that part. They are only compiled when a version string does
+	// not match the format to report where matching failed.
+	prefixesOnce sync.Once
+	prefixes     []*regexp.Regexp
+}
+
+// formatPart is either a literal piece of the format string, a convention or
+// the opening or closing bracket of an optional section.
+type formatPart struct {
+	literal    string
+	convention *internal.Convention
+	// segment is the index of the convention in segments.
+	segment int
+	// optional is true if the part is inside an optional section.
+	optional bool
+	// sectionStart and sectionEnd are true for the brackets of an optional
+	// section. closing is the index of the part closing the section opened by
+	// the part.
+	sectionStart bool
+	sectionEnd   bool
+	closing      int
+	// expr is the regex matching the part.
+	expr string
+}
+
+// formatSegment is a convention of the format along with where its value is
+// found in the format string, in a version string and in a Version.
+type formatSegment struct {
+	convention *internal.Convention
+	key        segmentKey
+	// part is the index of the convention in parts.
+	part int
+	// group is the index of the capturing group of the convention in re.
+	group int
+	// extra is the index of the value of the segment among the extra values
+	// of a Version, see Version.extraValues, or -1 if the segment is the
+	// first of its level.
+	extra int
+	// optional is true if the convention is inside an optional section, in
+	// which case its value is empty if the section is absent.
+	optional bool
+}
+
+// segmentKey identifies a segment independently of the format: the first
+// segment of the micro level of any format is {"micro", 0}.
+type segmentKey struct {
+	level string
+	// rank is the position of the segment within its level, 0 for the first.
+	rank int
+}
+
+// expected describes the part in a MismatchError.
+func (p formatPart) expected() string {
+	switch {
+	case p.convention != nil:
+		return p.convention.Name
+	case p.sectionStart:
+		return `"["`
+	case p.sectionEnd:
+		return `"]"`
+	}
+	return fmt.Sprintf("%q", p.literal)
+}
+
+// CompileFormat compiles the format string into a Format. The format string is
+// expected to follow the conventions defined in ConventionsRegex.
+//
+// Parts of the format enclosed in square brackets are optional, so the format
+// <YYYY>.<0M>.<0D>[.<MICRO>] matches both "2025.07.14" and "2025.07.14.2".
+// Literal brackets are written \[ and \].
+//
+// Example:
+//
+//	f, err := calver.CompileFormat("Rel-<YYYY>-<0M>-<0D>")
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(f.String()) // Rel-<YYYY>-<0M>-<0D>
+func CompileFormat(format string) (*Format, error) {
+	return compileFormat(format, nil)
+}
+
+// compileFormat compiles the format string recognizing the given custom
+// conventions in addition to the built-in ones.
+func compileFormat(format string, custom map[string]internal.Convention) (*Format, error) {
+	tokens, err := internal.TokenizeWith(format, custom)
+	if err != nil {
+		var fe *internal.FormatError
+		if errors.As(err, &fe) {
+			return nil, &FormatError{Format: format, Offset: fe.Offset, Reason: fe.Reason}
+		}
+		return nil, &FormatError{Format: format, Offset: -1, Reason: err.Error()}
+	}
+
+	f := &Format{
+		raw:    format,
+		levels: map[string]int{},
+		custom: custom,
+	}
+	var expr strings.Builder
+	expr.WriteString(`^`)
+	// open holds the indices of the parts opening the enclosing sections.
+	var open []int
+	for _, tok := range tokens {
+		switch {
+		case tok.SectionStart:
+			open = append(open, len(f.parts))
+			f.parts = append(f.parts, formatPart{sectionStart: true, expr: `(?:`})
+			expr.WriteString(`(?:`)
+			continue
+		case tok.SectionEnd:
+			f.parts[open[len(open)-1]].closing = len(f.parts)
+			open = open[:len(open)-1]
+			f.parts = append(f.parts, formatPart{sectionEnd: true, expr: `)?`})
+			expr.WriteString(`)?`)
+			continue
+		case !tok.IsConvention():
+			part := formatPart{literal: tok.Literal, expr: regexp.QuoteMeta(tok.Literal)}
+			f.parts = append(f.parts, part)
+			expr.WriteString(part.expr)
+			continue
+		}
+		meta, ok := internal.Conventions[tok.Convention]
+		part := formatPart{
+			convention: &meta,
+			optional:   len(open) > 0,
+			expr:       internal.ConventionsRegex[tok.Convention],
+		}
+		if !ok {
+			meta = custom[tok.Convention]
+			part.expr = fmt.Sprintf(`(?P<%s>%s)`, meta.Level, meta.Regex)
+		}
+		f.parts = append(f.parts, part)
+		expr.WriteString(part.expr)
+	}
+	expr.WriteString(`$`)
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return nil, &FormatError{Format: format, Offset: -1, Reason: err.Error()}
+	}
+	f.re = re
+	f.indexSegments()
+	return f, nil
+}
+
+// indexSegments sorts the conventions of the format into segments and finds
+// the capturing group of each of them. Several conventions of the same level
+// share the name of their capturing groups, which appear in the order of the
+// conventions in the format string.
+func (f *Format) indexSegments() {
+	extra := 0
+	for _, lv := range internal.ValidLevels {
+		rank := 0
+		for i, p := range f.parts {
+			if p.convention == nil || p.convention.Level != lv {
+				continue
+			}
+			seg := formatSegment{
+				convention: p.convention,
+				key:        segmentKey{level: lv, rank: rank},
+				part:       i,
+				extra:      -1,
+				optional:   p.optional,
+			}
+			if rank == 0 {
+				f.levels[lv] = len(f.segments)
+			} else {
+				seg.extra = extra
+				extra++
+			}
+			f.parts[i].segment = len(f.segments)
+			f.segments = append(f.segments, seg)
+			rank++
+		}
+	}
+
+	ranks := map[string]int{}
+	for group, name := range f.re.SubexpNames() {
+		i, ok := f.levels[name]
+		if !ok {
+			continue
+		}
+		f.segments[i+ranks[name]].group = group
+		ranks[name]++
+	}
+}
+
+// MustCompileFormat is like CompileFormat but panics if the format string is
+// invalid. It simplifies the initialization of global variables holding
+// compiled formats.
+func MustCompileFormat(format string) *Format {
+	f, err := CompileFormat(format)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// formatCacheSize is the number of formats kept by formatCache. Format strings
+// may come from untrusted input, so the cache is bounded rather than growing
+// with every distinct format string.
+const formatCacheSize = 256
+
+// formatCache holds the formats most recently compiled by ParseWithOptions and
+// NewCollectionWithOptions keyed by the format string.
+var formatCache = internal.NewLRU[*Format](formatCacheSize)
+
+// compileCached is like CompileFormat but reuses recently compiled formats.
+// Use CompileFormat to keep a format compiled for as long as needed.
+func compileCached(format string) (*Format, error) {
+	if f, ok := formatCache.Get(format); ok {
+		return f, nil
+	}
+	f, err := CompileFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	formatCache.Add(format, f)
+	return f, nil
+}
+
+// String returns the original format string.
+func (f *Format) String() string {
+	return f.raw
+}
+
+// Levels returns the levels used in the format, ordered from major to
+// modifier. A level is only returned once even if the format uses several
+// conventions of the level.
+func (f *Format) Levels() []string {
+	levels := make([]string, 0, len(f.levels))
+	for _, lv := range internal.ValidLevels {
+		if _, ok := f.levels[lv]; ok {
+			levels = append(levels, lv)
+		}
+	}
+	return levels
+}
+
+// Convention returns the convention used for the given level e.g. <YYYY> for
+// the major level of "<YYYY>.<0M>". It returns an empty string if the level is
+// not used in the format.
+func (f *Format) Convention(level string) string {
+	con := f.convention(strings.ToLower(level))
+	if con == nil {
+		return ""
+	}
+	return con.Name
+}
+
+// Conventions returns the conventions of the format from the most to the
+// least significant, which is the order of the segments of its versions, see
+// Version.Segments.
+//
+// Example:
+//
+//	f := calver.MustCompileFormat("<YYYY>.<0M>.<0D>.<MICRO>")
+//	fmt.Println(f.Conventions()) // [<YYYY> <0M> <0D> <MICRO>]
+func (f *Format) Conventions() []string {
+	names := make([]string, len(f.segments))
+	for i, seg := range f.segments {
+		names[i] = seg.convention.Name
+	}
+	return names
+}
+
+// convention returns the metadata of the first convention used for the given
+// level or nil if the level is not used in the format.
+func (f *Format) convention(level string) *internal.Convention {
+	i, ok := f.levels[level]
+	if !ok {
+		return nil
+	}
+	return f.segments[i].convention
+}
+
+// segment returns the index of the segment with the given key or -1 if the
+// format has no such segment.
+func (f *Format) segment(key segmentKey) int {
+	i, ok := f.levels[key.level]
+	if !ok {
+		return -1
+	}
+	i += key.rank
+	if i >= len(f.segments) || f.segments[i].key != key {
+		return -1
+	}
+	return i
+}
+
+// keys returns the keys of the segments of the format.
+func (f *Format) keys() []segmentKey {
+	keys := make([]segmentKey, len(f.segments))
+	for i, seg := range f.segments {
+		keys[i] = seg.key
+	}
+	return keys
+}
+
+// levelSegments returns the number of segments of the level.
+func (f *Format) levelSegments(level string) int {
+	n := 0
+	for _, seg := range f.segments {
+		if seg.key.level == level {
+			n++
+		}
+	}
+	return n
+}
+
+// optionalValue returns the value of the i-th segment to increment: 0 if the
+// segment is an absent optional counter and value otherwise.
+func (f *Format) optionalValue(i int, value string) string {
+	seg := f.segments[i]
+	if value == "" && seg.optional && seg.convention.Kind == internal.KindCounter {
+		return "0"
+	}
+	return value
+}
+
+// absent reports whether the i-th segment is an optional segment whose section
+// is absent, given the values of the segments.
+func (f *Format) absent(i int, values []string) bool {
+	return f.segments[i].optional && values[i] == ""
+}
+
+// calendarSegment returns the index of the first segment whose convention is
+// of one of the given kinds or -1 if there is none.
+func (f *Format) calendarSegment(kinds ...internal.Kind) int {
+	for i, seg := range f.segments {
+		if slices.Contains(kinds, seg.convention.Kind) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Parse creates a new Version object from a version string using the compiled
+// format.
+//
+// Example:
+//
+//	f := calver.MustCompileFormat("Rel-<YYYY>-<0M>-<0D>")
+//	ver, err := f.Parse("Rel-2025-07-14")
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(ver.String()) // Rel-2025-07-14
+func (f *Format) Parse(version string) (*Version, error) {
+	return parseFormats(version, []*Format{f}, &parseOptions{})
+}
+
+// MustParse is like Parse but panics if the version string does not match the
+// format.
+func (f *Format) MustParse(version string) *Version {
+	ver, err := f.Parse(version)
+	if err != nil {
+		panic(err)
+	}
+	return ver
+}
+
+// match returns the values captured for each segment, along with the number
+// of segments that are present in the version string, or nil if the version
+// string does not match the format. The values of the segments of absent
+// optional sections are empty.
+func (f *Format) match(version string) ([]string, int) {
+	loc := f.re.FindStringSubmatchIndex(version)
+	if loc == nil {
+		return nil, 0
+	}
+	values := make([]string, len(f.segments))
+	present := 0
+	for i, seg := range f.segments {
+		start, end := loc[2*seg.group], loc[2*seg.group+1]
+		if start < 0 {
+			continue
+		}
+		values[i] = version[start:end]
+		present++
+	}
+	return values, present
+}
+
+// mismatch returns a MismatchError describing where the version string stops
+// matching the format.
+func (f *Format) mismatch(version string) *MismatchError {
+	f.prefixesOnce.Do(func() {
+		var expr strings.Builder
+		expr.WriteString(`^`)
+		depth := 0
+		for _, p := range f.parts {
+			expr.WriteString(p.expr)
+			switch {
+			case p.sectionStart:
+				depth++
+			case p.sectionEnd:
+				depth--
+			}
+			// Sections that are still open are closed as required so that
+			// matching fails where the section stops matching.
+			re := regexp.MustCompile(expr.String() + strings.Repeat(`)`, depth))
+			re.Longest()
+			f.prefixes = append(f.prefixes, re)
+		}
+	})
+
+	err := &MismatchError{
+		Version:  version,
+		Format:   f.raw,
+		Expected: "end of version",
+	}
+	for i, re := range f.prefixes {
+		loc := re.FindStringIndex(version)
+		if loc == nil {
+			err.Expected = f.parts[i].expected()
+			break
+		}
+		err.Offset = loc[1]
+	}
+	return err
+}
+
+// truncated returns the format itself followed by the formats made of the
+// format string truncated after each of its conventions, from the longest to
+// the shortest. For example, the format <YYYY>.<0M>.<MICRO> returns the formats
+// <YYYY>.<0M>.<MICRO>, <YYYY>.<0M> and <YYYY>. Optional sections cut by the
+// truncation are closed.
+func (f *Format) truncated() ([]*Format, error) {
+	formats := []*Format{f}
+	var raw strings.Builder
+	var prefixes []string
+	depth := 0
+	for i, p := range f.parts {
+		switch {
+		case p.sectionStart:
+			raw.WriteString("[")
+			depth++
+			continue
+		case p.sectionEnd:
+			raw.WriteString("]")
+			depth--
+			continue
+		case p.convention == nil:
+			raw.WriteString(escapeLiteral(p.literal))
+			continue
+		}
+		raw.WriteString(p.convention.Name)
+		if slices.ContainsFunc(f.parts[i+1:], func(p formatPart) bool { return !p.sectionEnd }) {
+			prefixes = append(prefixes, raw.String()+strings.Repeat("]", depth))
+		}
+	}
+	for i := len(prefixes) - 1; i >= 0; i-- {
+		compile := compileCached
+		if f.custom != nil {
+			compile = func(format string) (*Format, error) {
+				return compileFormat(format, f.custom)
+			}
+		}
+		prefix, err := compile(prefixes[i])
+		if err != nil {
+			return nil, err
+		}
+		formats = append(formats, prefix)
+	}
+	return formats, nil
+}
+
+// literalEscaper escapes the square brackets of literal text.
+var literalEscaper = strings.NewReplacer("[", `\[`, "]", `\]`)
+
+// escapeLiteral returns the literal text as it is written in a format string,
+// i.e. with its square brackets escaped so that they do not start or end an
+// optional section.
+func escapeLiteral(s string) string {
+	return literalEscaper.Replace(s)
+}
+
+// render returns the format string with every convention replaced by the
+// value returned by valueOf for its segment. Only the parts up to and
+// including the part at index upto are rendered. Optional sections whose
+// conventions all have empty values are left out.
+func (f *Format) render(valueOf func(segment int) string, upto int) string {
+	var out strings.Builder
+	for i := 0; i < len(f.parts) && i <= upto; i++ {
+		p := f.parts[i]
+		switch {
+		case p.sectionStart:
+			set := slices.ContainsFunc(f.parts[i:p.closing], func(p formatPart) bool {
+				return p.convention != nil && valueOf(p.segment) != ""
+			})
+			if !set {
+				i = p.closing
+			}
+		case p.convention != nil:
+			out.WriteString(valueOf(p.segment))
+		default:
+			out.WriteString(p.literal)
+		}
+	}
+	return out.String()
+}
+
+// compileFormats compiles the format strings, reusing previously compiled
+// formats where possible.
+func compileFormats(formats []string) ([]*Format, error) {
+	compiled := make([]*Format, 0, len(formats))
+	for _, f := range formats {
+		c, err := compileCached(f)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled, nil
+}
+
+// parseFormats parses the version string using the format chosen among the
+// matching formats by the resolution of the options, see WithResolution.
+func parseFormats(version string, formats []*Format, o *parseOptions) (*Version, error) {
+	var matching *Format
+	var values []string
+	// best is the number of segments captured by the matching format or its
+	// score with ResolveMostSpecific.
+	var best int
+	var ambiguous []string
+	var calErr error
+	for _, f := range formats {
+		currValues, rank := f.match(version)
+		if currValues == nil {
+			continue
+		}
+		if o.resolution == ResolveMostSpecific {
+			rank = f.scoreMatch(version, currValues).Score
+		}
+		if matching != nil {
+			switch o.resolution {
+			case ResolveFirstMatch:
+				continue
+			case ResolveErrorOnAmbiguity:
+				// Every matching format is recorded below.
+			default:
+				if rank <= best {
+					continue
+				}
+			}
+		}
+		if o.strictCalendar {
+			if err := f.validateCalendar(version, currValues); err != nil {
+				if calErr == nil {
+					calErr = err
+				}
+				continue
+			}
+		}
+		if o.resolution == ResolveErrorOnAmbiguity {
+			ambiguous = append(ambiguous, f.raw)
+			if matching != nil {
+				continue
+			}
+		}
+		matching = f
+		values = currValues
+		best = rank
+	}
+
+	if matching == nil {
+		if calErr != nil {
+			return nil, calErr
+		}
+		var err *MismatchError
+		for _, f := range formats {
+			currErr := f.mismatch(version)
+			if err == nil || currErr.Offset > err.Offset {
+				err = currErr
+			}
+		}
+		return nil, err
+	}
+	if len(ambiguous) > 1 {
+		return nil, &AmbiguityError{Version: version, Formats: ambiguous}
+	}
+
+	if !slices.ContainsFunc(values, func(value string) bool { return value != "" }) {
+		return nil, fmt.Errorf(
+			"malformed calver format: %s - "+
+				"make sure to use at least one version: %w",
+			version,
+			ErrNoLevels,
+		)
+	}
+	return newVersion(matching, values), nil
+}
+
+// newVersion returns the version of the format with the given values of its
+// segments.
+func newVersion(f *Format, values []string) *Version {
+	c := &Version{Format: f.raw, format: f}
+	for i, value := range values {
+		c.setSegmentValue(f, i, value)
+	}
+	return c
+}
@@ -0,0 +1,263 @@
+package calver_test
+
+import (
+	"testing"
+
+	"github.com/shazib-summar/go-calver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompileFormat(t *testing.T) {
+	tests := []struct {
+		name       string
+		format     string
+		wantLevels []string
+		wantErr    bool
+	}{
+		{name: "1", format: "<YYYY>.<0M>.<MICRO>", wantLevels: []string{"major", "minor", "micro"}},
+		{name: "2", format: "Rel-<YYYY>-<0M>-<0D>", wantLevels: []string{"major", "minor", "micro"}},
+		{name: "3", format: "<0D>.<0M>.<YYYY>", wantLevels: []string{"major", "minor", "micro"}},
+		{name: "4", format: "<YYYY>-<MODIFIER>", wantLevels: []string{"major", "modifier"}},
+		{name: "5", format: "<YYYY>-<YYYY>", wantErr: true},
+		{name: "6", format: "foobar", wantErr: true},
+		{name: "7", format: "<YYYY>.<0M>.<0W>", wantLevels: []string{"major", "minor", "micro"}},
+		{name: "8", format: "<YYYY>.<0M>.<MM>", wantErr: true},
+		{name: "9", format: "[beta]<YYYY>", wantErr: true},
+		{name: "10", format: `\[beta\]<YYYY>`, wantLevels: []string{"major"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := calver.CompileFormat(test.format)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.format, f.String())
+			assert.Equal(t, test.wantLevels, f.Levels())
+		})
+	}
+}
+
+func TestMustCompileFormat(t *testing.T) {
+	assert.NotPanics(t, func() { calver.MustCompileFormat("<YYYY>.<0M>") })
+	assert.Panics(t, func() { calver.MustCompileFormat("foobar") })
+}
+
+func TestFormatConvention(t *testing.T) {
+	f := calver.MustCompileFormat("<YYYY>.<0M>.<MICRO>")
+	assert.Equal(t, "<YYYY>", f.Convention("major"))
+	assert.Equal(t, "<0M>", f.Convention("Minor"))
+	assert.Equal(t, "<MICRO>", f.Convention("micro"))
+	assert.Equal(t, "", f.Convention("modifier"))
+}
+
+func TestFormatConventions(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		want   []string
+	}{
+		{name: "1", format: "<YYYY>.<0M>.<MICRO>", want: []string{"<YYYY>", "<0M>", "<MICRO>"}},
+		{name: "2", format: "<YYYY>.<0M>.<0D>.<MICRO>", want: []string{"<YYYY>", "<0M>", "<0D>", "<MICRO>"}},
+		{name: "3", format: "<MODIFIER>-<0M>-<YYYY>", want: []string{"<YYYY>", "<0M>", "<MODIFIER>"}},
+		{name: "4", format: "<MICRO>+<0D>.<0M>.<YYYY>", want: []string{"<YYYY>", "<0M>", "<MICRO>", "<0D>"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f := calver.MustCompileFormat(test.format)
+			assert.Equal(t, test.want, f.Conventions())
+		})
+	}
+}
+
+func TestFormatParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		versions []string
+		wantErr  bool
+	}{
+		{name: "1", format: "<YYYY>.<0M>.<MICRO>", versions: []string{"2025.07.3", "2025.07.14", "2024.12.0"}},
+		{name: "2", format: "Rel-<YYYY>-<0M>-<0D>", versions: []string{"Rel-2025-07-14", "Rel-2024-01-01"}},
+		{name: "3", format: "v<YYYY><0M><0D>", versions: []string{"v20250723"}},
+		{name: "4", format: "<YYYY>.<0M>.<MICRO>", versions: []string{"2025.7.3"}, wantErr: true},
+		{name: "5", format: "<YYYY>.<0M>", versions: []string{"2025a07"}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := calver.CompileFormat(test.format)
+			assert.NoError(t, err)
+			for _, version := range test.versions {
+				ver, err := f.Parse(version)
+				if test.wantErr {
+					assert.Error(t, err)
+					continue
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, test.format, ver.Format)
+				assert.Equal(t, version, ver.String())
+			}
+		})
+	}
+}
+
+func TestFormatOptionalSections(t *testing.T) {
+	tests := []struct {
+		name       string
+		format     string
+		version    string
+		wantValues []string
+		wantErr    bool
+	}{
+		{name: "1", format: "<YYYY>.<0M>.<0D>[.<MICRO>]", version: "2025.07.14", wantValues: []string{"2025", "07", "14", ""}},
+		{name: "2", format: "<YYYY>.<0M>.<0D>[.<MICRO>]", version: "2025.07.14.2", wantValues: []string{"2025", "07", "14", "2"}},
+		{name: "3", format: "<YYYY>.<0M>.<0D>[.<MICRO>]", version: "2025.07.14.", wantErr: true},
+		{name: "4", format: "<YYYY>[.<0M>[.<0D>]]", version: "2025", wantValues: []string{"2025", "", ""}},
+		{name: "5", format: "<YYYY>[.<0M>[.<0D>]]", version: "2025.07", wantValues: []string{"2025", "07", ""}},
+		{name: "6", format: "<YYYY>[.<0M>[.<0D>]]", version: "2025.07.14", wantValues: []string{"2025", "07", "14"}},
+		{name: "7", format: "<YYYY>[.<0M>[.<0D>]]", version: "2025..14", wantErr: true},
+		{name: "8", format: "v<MAJOR>[.<MINOR>][-rc<MODIFIER>]", version: "v3-rc1", wantValues: []string{"3", "", "1"}},
+		{name: "9", format: "[<YYYY>.]<0M>.<MICRO>", version: "07.3", wantValues: []string{"", "07", "3"}},
+		{name: "10", format: `<YYYY>\[.<0M>\][.<MICRO>]`, version: "2025[.07].1", wantValues: []string{"2025", "07", "1"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := calver.CompileFormat(test.format)
+			assert.NoError(t, err)
+
+			ver, err := f.Parse(test.version)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			var values []string
+			for _, seg := range ver.Segments() {
+				values = append(values, seg.Value)
+			}
+			assert.Equal(t, test.wantValues, values)
+			assert.Equal(t, test.version, ver.String())
+		})
+	}
+}
+
+func TestFormatOptionalSectionsString(t *testing.T) {
+	ver := calver.MustCompileFormat("<YYYY>.<0M>.<0D>[.<MICRO>]").MustParse("2025.07.14.2")
+	assert.NoError(t, ver.SetSegment(3, ""))
+	assert.Equal(t, "2025.07.14", ver.String())
+
+	ver = &calver.Version{Format: "<YYYY>[.<0M>[.<0D>]]-<MODIFIER>", Major: "2025", Minor: "07", Micro: "14", Modifier: "rc1"}
+	assert.Equal(t, "2025.07.14-rc1", ver.String())
+	ver.Micro = ""
+	assert.Equal(t, "2025.07-rc1", ver.String())
+	ver.Minor = ""
+	assert.Equal(t, "2025-rc1", ver.String())
+}
+
+func TestFormatLiteralMetacharacters(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		version string
+		other   string
+	}{
+		{name: "1", format: "<YYYY>.<0M>", version: "2025.07", other: "2025a07"},
+		{name: "2", format: "v<MAJOR>+build<MICRO>", version: "v1+build2", other: "v11build2"},
+		{name: "3", format: "(<YYYY>)", version: "(2025)", other: "2025"},
+		// Unescaped brackets start an optional section since optional
+		// sections were introduced, so literal brackets must be escaped.
+		{name: "4", format: `<YYYY>\[<MM>\]`, version: "2025[7]", other: "20257"},
+		{name: "5", format: "release*<0M>", version: "release*07", other: "releaseeee07"},
+		{name: "6", format: "<YYYY>?<0M>", version: "2025?07", other: "202507"},
+		{name: "7", format: "<YYYY>|<0M>", version: "2025|07", other: "2025"},
+		{name: "8", format: "<YYYY>{2}<0M>", version: "2025{2}07", other: "202507"},
+		{name: "9", format: "^<YYYY>$", version: "^2025$", other: "2025"},
+		{name: "10", format: `<YYYY>\<0M>`, version: `2025\07`, other: "202507"},
+		{name: "11", format: "<YYYY>.*<0M>", version: "2025.*07", other: "2025.abc07"},
+		{name: "12", format: "<YYYY>(<0M>", version: "2025(07", other: "202507"},
+		{name: "13", format: `<YYYY>\]<0M>`, version: "2025]07", other: "202507"},
+		{name: "14", format: "<YYYY>}<0M>", version: "2025}07", other: "202507"},
+		{name: "15", format: `<YYYY>\d<0M>`, version: `2025\d07`, other: "2025007"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := calver.CompileFormat(test.format)
+			assert.NoError(t, err)
+
+			ver, err := f.Parse(test.version)
+			assert.NoError(t, err)
+			assert.Equal(t, test.version, ver.String())
+
+			_, err = f.Parse(test.other)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCompileFormatMalformed(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+	}{
+		{name: "1", format: ""},
+		{name: "2", format: "foobar"},
+		{name: "3", format: "<YYYY>-<YY>"},
+		{name: "4", format: "<0M>(<MM>"},
+		{name: "5", format: "[<YYY>]"},
+		{name: "6", format: "<YYYY>[.<0M>"},
+		{name: "7", format: "<YYYY>].<0M>"},
+		{name: "8", format: "<YYYY>[-]<0M>"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.NotPanics(t, func() {
+				_, err := calver.CompileFormat(test.format)
+				assert.Error(t, err)
+				_, err = calver.Parse(test.format, "2025")
+				assert.Error(t, err)
+			})
+		})
+	}
+}
+
+func TestFormatMustParse(t *testing.T) {
+	f := calver.MustCompileFormat("<YYYY>.<0M>.<MICRO>")
+	assert.NotPanics(t, func() { f.MustParse("2025.07.3") })
+	assert.Panics(t, func() { f.MustParse("2025-07-3") })
+}
+
+func TestWithCompiledFormat(t *testing.T) {
+	f := calver.MustCompileFormat("<YYYY>.<0M>.<0D>")
+	ver, err := calver.ParseWithOptions(
+		"2025-07-14",
+		calver.WithFormat("<YYYY>-<0M>-<0D>"),
+		calver.WithCompiledFormat(f),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "<YYYY>-<0M>-<0D>", ver.Format)
+
+	ver, err = calver.ParseWithOptions("2025.07.14", calver.WithCompiledFormat(f))
+	assert.NoError(t, err)
+	assert.Equal(t, "2025.07.14", ver.String())
+	assert.Equal(t, "2025.07", ver.Series("minor"))
+}
+
+func BenchmarkParseWithOptions(b *testing.B) {
+	for b.Loop() {
+		_, _ = calver.ParseWithOptions("2025.07.14", calver.WithFormat("<YYYY>.<0M>.<0D>"))
+	}
+}
+
+func BenchmarkFormatParse(b *testing.B) {
+	f := calver.MustCompileFormat("<YYYY>.<0M>.<0D>")
+	for b.Loop() {
+		_, _ = f.Parse("2025.07.14")
+	}
+}
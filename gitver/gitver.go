@@ -0,0 +1,139 @@
+// Package gitver discovers CalVer versions from the tags of a local git
+// repository and proposes the next tag to release.
+//
+// Example:
+//
+//	tags, err := gitver.Discover(".", "v<YYYY>.<0M>.<MICRO>")
+//	if err != nil {
+//	    return err
+//	}
+//	for _, skipped := range tags.Skipped {
+//	    log.Printf("skipped tag %s: %v", skipped.Tag, skipped.Err)
+//	}
+//	next, err := tags.Next(time.Now())
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(next.String()) // v2025.07.4
+package gitver
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shazib-summar/go-calver"
+)
+
+// Tags holds the tags of a repository parsed with a set of formats.
+type Tags struct {
+	// Versions are the tags that match one of the formats, sorted in
+	// ascending order.
+	Versions calver.Collection
+	// Skipped are the tags that do not match any of the formats, sorted by
+	// name.
+	Skipped []Skipped
+
+	formats []*calver.Format
+}
+
+// Skipped is a tag that does not match any of the formats.
+type Skipped struct {
+	Tag string
+	// Err is the reason the tag was skipped, usually a *calver.MismatchError.
+	Err error
+}
+
+// Discover lists the tags of the git repository at dir using ListTags and
+// parses them with the given formats. Tags that do not match any of the
+// formats are skipped and reported in Tags.Skipped.
+//
+// It returns an error if the tags cannot be listed, if no format is given or if
+// any of the formats is invalid.
+func Discover(dir string, formats ...string) (*Tags, error) {
+	tags, err := ListTags(dir)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(tags, formats...)
+}
+
+// Parse parses the given tags with the given formats like Discover. It is
+// useful when the tags come from somewhere else, e.g. a remote repository.
+func Parse(tags []string, formats ...string) (*Tags, error) {
+	if len(formats) == 0 {
+		return nil, fmt.Errorf("parsing tags: %w", calver.ErrNoFormat)
+	}
+	compiled := make([]*calver.Format, 0, len(formats))
+	for _, format := range formats {
+		f, err := calver.CompileFormat(format)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, f)
+	}
+
+	t := &Tags{formats: compiled}
+	for _, tag := range tags {
+		ver, err := calver.ParseWithOptions(tag, calver.WithCompiledFormat(compiled...))
+		if err != nil {
+			t.Skipped = append(t.Skipped, Skipped{Tag: tag, Err: err})
+			continue
+		}
+		t.Versions = append(t.Versions, ver)
+	}
+	t.Versions.SortWith()
+	return t, nil
+}
+
+// Latest returns the greatest version or nil if no tag matched the formats.
+func (t *Tags) Latest() *calver.Version {
+	if len(t.Versions) == 0 {
+		return nil
+	}
+	return t.Versions[len(t.Versions)-1]
+}
+
+// LatestPerSeries returns the greatest version of every series at the given
+// level, see calver.Version.Series, in ascending order.
+//
+// Example:
+//
+//	tags, err := gitver.Parse(
+//	    []string{"2025.06.0", "2025.06.1", "2025.07.0"},
+//	    "<YYYY>.<0M>.<MICRO>",
+//	)
+//	if err != nil {
+//	    return err
+//	}
+//	latest := tags.LatestPerSeries("minor") // 2025.06.1, 2025.07.0
+func (t *Tags) LatestPerSeries(level string) calver.Collection {
+	var latest calver.Collection
+	index := make(map[string]int)
+	// Versions are sorted, so the last version of a series is its greatest.
+	for _, ver := range t.Versions {
+		series := ver.Series(level)
+		if i, ok := index[series]; ok {
+			latest[i] = ver
+			continue
+		}
+		index[series] = len(latest)
+		latest = append(latest, ver)
+	}
+	latest.SortWith()
+	return latest
+}
+
+// Next proposes the next tag to release at the given time. It is the result of
+// calver.Version.Next for the latest version, or the first version of the
+// first format for the time if no tag matched the formats.
+//
+// It returns an error if the latest version is later than now, see
+// calver.ErrClockBehind, or if the formats require a modifier that is not
+// numeric, see calver.ErrNoModifier.
+func (t *Tags) Next(now time.Time) (*calver.Version, error) {
+	latest := t.Latest()
+	if latest == nil {
+		return t.formats[0].FromTime(now)
+	}
+	return latest.Next(now)
+}
@@ -0,0 +1,126 @@
+package gitver_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shazib-summar/go-calver"
+	"github.com/shazib-summar/go-calver/gitver"
+	"github.com/shazib-summar/go-calver/internal/gittest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscover(t *testing.T) {
+	dir := gittest.NewRepo(t, "v2025.07.1", "v2025.06.0", "v2025.07.10", "v2025.07.2", "nightly", "v1.2.3")
+
+	tags, err := gitver.Discover(dir, "v<YYYY>.<0M>.<MICRO>")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"v2025.06.0", "v2025.07.1", "v2025.07.2", "v2025.07.10"}, versionStrings(tags.Versions))
+	assert.Equal(t, "v2025.07.10", tags.Latest().String())
+
+	assert.Len(t, tags.Skipped, 2)
+	assert.Equal(t, "nightly", tags.Skipped[0].Tag)
+	assert.Equal(t, "v1.2.3", tags.Skipped[1].Tag)
+	var me *calver.MismatchError
+	assert.True(t, errors.As(tags.Skipped[0].Err, &me))
+
+	next, err := tags.Next(date(2025, 7, 20))
+	assert.NoError(t, err)
+	assert.Equal(t, "v2025.07.11", next.String())
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		tags       []string
+		formats    []string
+		level      string
+		now        time.Time
+		wantSeries []string
+		wantNext   string
+		wantErr    bool
+	}{
+		{
+			name:       "1",
+			tags:       []string{"2025.06.0", "2025.07.0", "2025.06.1", "2024.12.5"},
+			formats:    []string{"<YYYY>.<0M>.<MICRO>"},
+			level:      "minor",
+			now:        date(2025, 8, 1),
+			wantSeries: []string{"2024.12.5", "2025.06.1", "2025.07.0"},
+			wantNext:   "2025.08.0",
+		},
+		{
+			name:       "2",
+			tags:       []string{"2025.06.0", "2025.07.0", "2025.06.1", "2024.12.5"},
+			formats:    []string{"<YYYY>.<0M>.<MICRO>"},
+			level:      "major",
+			now:        date(2025, 7, 1),
+			wantSeries: []string{"2024.12.5", "2025.07.0"},
+			wantNext:   "2025.07.1",
+		},
+		{
+			name:       "3",
+			tags:       []string{"24.04", "24.04.1", "22.04.5", "24.10"},
+			formats:    []string{"<0Y>.<0M>", "<0Y>.<0M>.<MICRO>"},
+			level:      "minor",
+			now:        date(2025, 4, 17),
+			wantSeries: []string{"22.04.5", "24.04.1", "24.10"},
+			wantNext:   "25.04",
+		},
+		{
+			name:       "4",
+			tags:       []string{"latest"},
+			formats:    []string{"<YYYY>.<0M>.<MICRO>"},
+			level:      "minor",
+			now:        date(2025, 8, 1),
+			wantSeries: nil,
+			wantNext:   "2025.08.0",
+		},
+		{
+			name:       "5",
+			tags:       []string{"2025.07.0"},
+			formats:    []string{"<YYYY>.<0M>.<MICRO>"},
+			level:      "micro",
+			now:        date(2025, 6, 1),
+			wantSeries: []string{"2025.07.0"},
+			wantErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tags, err := gitver.Parse(test.tags, test.formats...)
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantSeries, versionStrings(tags.LatestPerSeries(test.level)))
+
+			next, err := tags.Next(test.now)
+			if test.wantErr {
+				assert.ErrorIs(t, err, calver.ErrClockBehind)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantNext, next.String())
+		})
+	}
+}
+
+func TestParseInvalidFormat(t *testing.T) {
+	_, err := gitver.Parse([]string{"2025.07.0"})
+	assert.ErrorIs(t, err, calver.ErrNoFormat)
+
+	_, err = gitver.Parse([]string{"2025.07.0"}, "no conventions")
+	assert.ErrorIs(t, err, calver.ErrInvalidFormat)
+}
+
+func versionStrings(c calver.Collection) []string {
+	var s []string
+	for _, ver := range c {
+		s = append(s, ver.String())
+	}
+	return s
+}
+
+func date(year, month, day int) time.Time {
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,161 @@
+package gitver
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// ListTags returns the names of the tags of the git repository at dir, sorted
+// by name. It runs `git tag --list` and falls back to ReadTags if the git
+// executable cannot be found.
+func ListTags(dir string) ([]string, error) {
+	if _, err := exec.LookPath("git"); err != nil {
+		return ReadTags(dir)
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", "-C", dir, "tag", "--list")
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("listing tags of %q: %w: %s", dir, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var tags []string
+	for _, line := range strings.Split(string(out), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			tags = append(tags, line)
+		}
+	}
+	slices.Sort(tags)
+	return tags, nil
+}
+
+// ReadTags returns the names of the tags of the git repository at dir, sorted
+// by name, without running git. The tags are read from the loose references in
+// refs/tags and from the packed-refs file of the repository. dir is either the
+// work tree of the repository, i.e. the directory containing .git, or the git
+// directory itself, e.g. of a bare repository.
+func ReadTags(dir string) ([]string, error) {
+	gitDir, err := findGitDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	tagsDir := filepath.Join(gitDir, "refs", "tags")
+	err = filepath.WalkDir(tagsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		name, err := filepath.Rel(tagsDir, path)
+		if err != nil {
+			return err
+		}
+		seen[filepath.ToSlash(name)] = true
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("reading tags of %q: %w", dir, err)
+	}
+
+	packed, err := readPackedTags(filepath.Join(gitDir, "packed-refs"))
+	if err != nil {
+		return nil, fmt.Errorf("reading tags of %q: %w", dir, err)
+	}
+	for _, name := range packed {
+		seen[name] = true
+	}
+
+	tags := make([]string, 0, len(seen))
+	for name := range seen {
+		tags = append(tags, name)
+	}
+	slices.Sort(tags)
+	return tags, nil
+}
+
+// readPackedTags returns the names of the tags in a packed-refs file. A missing
+// file has no tags.
+func readPackedTags(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var tags []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		// Comments start with # and peeled tags, i.e. the commit an annotated
+		// tag points to, with ^.
+		if line == "" || line[0] == '#' || line[0] == '^' {
+			continue
+		}
+		_, ref, ok := strings.Cut(line, " ")
+		if !ok {
+			continue
+		}
+		if name, ok := strings.CutPrefix(ref, "refs/tags/"); ok {
+			tags = append(tags, name)
+		}
+	}
+	return tags, scanner.Err()
+}
+
+// findGitDir returns the directory holding the references of the repository
+// at dir. It follows the "gitdir:" file used by linked work trees and
+// submodules and the commondir file of linked work trees.
+func findGitDir(dir string) (string, error) {
+	gitDir := filepath.Join(dir, ".git")
+	info, err := os.Stat(gitDir)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		// dir may be a bare repository or a git directory itself.
+		if _, err := os.Stat(filepath.Join(dir, "refs")); err != nil {
+			return "", fmt.Errorf("%q is not a git repository", dir)
+		}
+		gitDir = dir
+	case err != nil:
+		return "", err
+	case !info.IsDir():
+		data, err := os.ReadFile(gitDir)
+		if err != nil {
+			return "", err
+		}
+		path, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+		if !ok {
+			return "", fmt.Errorf("%q is not a valid .git file", gitDir)
+		}
+		gitDir = resolvePath(dir, strings.TrimSpace(path))
+	}
+
+	if data, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		gitDir = resolvePath(gitDir, strings.TrimSpace(string(data)))
+	}
+	return gitDir, nil
+}
+
+// resolvePath returns path if it is absolute and path relative to base
+// otherwise.
+func resolvePath(base, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(base, path)
+}
@@ -0,0 +1,109 @@
+package gitver_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shazib-summar/go-calver/gitver"
+	"github.com/shazib-summar/go-calver/internal/gittest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListTags(t *testing.T) {
+	dir := gittest.NewRepo(t, "v2025.07.1", "v2025.06.0", "release/2025.07.0", "latest")
+
+	tags, err := gitver.ListTags(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"latest", "release/2025.07.0", "v2025.06.0", "v2025.07.1"}, tags)
+
+	// Packing the references must not change the result of ReadTags.
+	loose, err := gitver.ReadTags(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, tags, loose)
+	gittest.Git(t, dir, "pack-refs", "--all")
+	gittest.Git(t, dir, "tag", "v2025.08.0")
+	packed, err := gitver.ReadTags(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, append(tags, "v2025.08.0"), packed)
+
+	_, err = gitver.ListTags(t.TempDir())
+	assert.Error(t, err)
+}
+
+func TestReadTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		dir     string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "1",
+			files: map[string]string{
+				".git/refs/tags/v2025.07.1":    "0000000000000000000000000000000000000001\n",
+				".git/refs/tags/rel/2025.07.0": "0000000000000000000000000000000000000002\n",
+				".git/packed-refs": "# pack-refs with: peeled fully-peeled sorted\n" +
+					"0000000000000000000000000000000000000003 refs/heads/main\n" +
+					"0000000000000000000000000000000000000004 refs/tags/v2025.06.0\n" +
+					"^0000000000000000000000000000000000000003\n" +
+					"0000000000000000000000000000000000000001 refs/tags/v2025.07.1\n",
+			},
+			want: []string{"rel/2025.07.0", "v2025.06.0", "v2025.07.1"},
+		},
+		{
+			name: "2",
+			files: map[string]string{
+				"bare/refs/heads/main":      "0000000000000000000000000000000000000001\n",
+				"bare/refs/tags/v2025.07.1": "0000000000000000000000000000000000000001\n",
+			},
+			dir:  "bare",
+			want: []string{"v2025.07.1"},
+		},
+		{
+			name: "3",
+			files: map[string]string{
+				"main/.git/refs/tags/v2025.07.1":                  "0000000000000000000000000000000000000001\n",
+				"main/.git/worktrees/feature/commondir":           "../..\n",
+				"feature/.git":                                    "gitdir: ../main/.git/worktrees/feature\n",
+				"main/.git/worktrees/feature/refs/heads/.gitkeep": "",
+			},
+			dir:  "feature",
+			want: []string{"v2025.07.1"},
+		},
+		{
+			name:  "4",
+			files: map[string]string{".git/refs/heads/main": "0000000000000000000000000000000000000001\n"},
+			want:  []string{},
+		},
+		{
+			name:    "5",
+			files:   map[string]string{"README.md": ""},
+			wantErr: true,
+		},
+		{
+			name:    "6",
+			files:   map[string]string{".git": "not a git file\n"},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := t.TempDir()
+			for name, content := range test.files {
+				path := filepath.Join(root, filepath.FromSlash(name))
+				assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+				assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+			}
+			got, err := gitver.ReadTags(filepath.Join(root, test.dir))
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
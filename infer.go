@@ -0,0 +1,356 @@
+package calver
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/shazib-summar/go-calver/internal"
+)
+
+// inferWeights is how plausible a convention is when inferring a format.
+// Calendar conventions are preferred over counters, and counters over leaving
+// the value in the modifier.
+var inferWeights = map[string]int{
+	"<YYYY>":     4,
+	"<0Y>":       3,
+	"<YY>":       3,
+	"<MAJOR>":    1,
+	"<0M>":       3,
+	"<MM>":       3,
+	"<MINOR>":    1,
+	"<0W>":       2,
+	"<WW>":       2,
+	"<0D>":       3,
+	"<DD>":       3,
+	"<MICRO>":    1,
+	"<MODIFIER>": 0,
+}
+
+// inferMaxLevels is the number of numeric levels, i.e. major, minor and
+// micro, a format can have. Any further digits are left in the modifier.
+const inferMaxLevels = 3
+
+// candidate is a format proposed by InferFormat.
+type candidate struct {
+	format *Format
+	// matched is the number of samples matching the format.
+	matched int
+	// valid is the number of matching samples with plausible calendar values.
+	valid int
+	// weight is the sum of the inferWeights of the conventions of the format.
+	weight int
+}
+
+// InferFormat proposes formats for the given sample versions, e.g. the tags of
+// a repository, from the most to the least likely. Every sample is split into
+// runs of digits and the text between them. The first runs of digits become
+// the major, minor and micro levels, using calendar conventions where the
+// values allow it, and what follows becomes the modifier.
+//
+// The formats are ranked by the number of samples they match, then by the
+// number of samples whose calendar values are plausible, i.e. valid dates with
+// a year between 1900 and 2199, and then by preferring calendar conventions
+// over counters.
+//
+// Example:
+//
+//	formats, err := calver.InferFormat("22.04.6", "24.04.1", "24.10.0")
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(formats[0]) // <0Y>.<0M>.<MICRO>
+//
+//	formats, err = calver.InferFormat("RELEASE.2025-07-23T15-54-02Z")
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(formats[0]) // RELEASE.<YYYY>-<0M>-<0D>T<MODIFIER>Z
+//
+// It returns an error if no samples are given or if no format can be inferred,
+// i.e. none of the samples contains a digit.
+func InferFormat(samples ...string) ([]string, error) {
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("cannot infer a format: no samples provided")
+	}
+
+	var candidates []*candidate
+	seen := make(map[string]bool)
+	for _, sample := range samples {
+		for _, raw := range inferSample(sample) {
+			if seen[raw] {
+				continue
+			}
+			seen[raw] = true
+			f, err := CompileFormat(raw)
+			if err != nil {
+				continue
+			}
+			if values, _ := f.match(sample); values == nil {
+				continue
+			}
+			candidates = append(candidates, &candidate{format: f, weight: inferWeight(f)})
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("cannot infer a format from %q", samples)
+	}
+
+	for _, c := range candidates {
+		for _, sample := range samples {
+			values, _ := c.format.match(sample)
+			if values == nil {
+				continue
+			}
+			c.matched++
+			if c.format.plausible(sample, values) {
+				c.valid++
+			}
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.matched != b.matched {
+			return a.matched > b.matched
+		}
+		if a.valid != b.valid {
+			return a.valid > b.valid
+		}
+		return a.weight > b.weight
+	})
+
+	formats := make([]string, len(candidates))
+	for i, c := range candidates {
+		formats[i] = c.format.raw
+	}
+	return formats, nil
+}
+
+// inferWeight returns the sum of the inferWeights of the conventions of the
+// format plus one for every pair of consecutive short year, month, week or day
+// conventions with the same padding, so 25.7.4 is <YY>.<MM>.<DD> rather than
+// <0Y>.<MM>.<DD>.
+func inferWeight(f *Format) int {
+	weight := 0
+	var prev *internal.Convention
+	for _, part := range f.parts {
+		con := part.convention
+		if con == nil {
+			continue
+		}
+		weight += inferWeights[con.Name]
+		if !con.Kind.IsCalendar() || con.Kind == internal.KindYear {
+			prev = nil
+			continue
+		}
+		if prev != nil && prev.Padded == con.Padded {
+			weight++
+		}
+		prev = con
+	}
+	return weight
+}
+
+// plausible reports whether the calendar values captured by the format are a
+// valid date with a year between 1900 and 2199.
+func (f *Format) plausible(version string, values []string) bool {
+	if f.validateCalendar(version, values) != nil {
+		return false
+	}
+	if i := f.calendarSegment(internal.KindYear, internal.KindShortYear); i >= 0 {
+		year, _ := internal.Year(f.segments[i].convention.Kind, values[i])
+		return year >= 1900 && year < 2200
+	}
+	return true
+}
+
+// inferSample returns the formats the sample may have been created with, in
+// order of preference for formats of equal weight.
+func inferSample(sample string) []string {
+	// The sample is prefix + digits[0] + seps[0] + digits[1] + seps[1] ...
+	start := strings.IndexFunc(sample, isDigitRune)
+	if start < 0 {
+		return nil
+	}
+	prefix := sample[:start]
+	var digits, seps []string
+	for rest := sample[start:]; rest != ""; {
+		end := strings.IndexFunc(rest, func(r rune) bool { return !isDigitRune(r) })
+		if end < 0 {
+			end = len(rest)
+		}
+		digits = append(digits, rest[:end])
+		rest = rest[end:]
+		end = strings.IndexFunc(rest, isDigitRune)
+		if end < 0 {
+			end = len(rest)
+		}
+		seps = append(seps, rest[:end])
+		rest = rest[end:]
+	}
+
+	var formats []string
+	for k := min(inferMaxLevels, len(digits)); k >= 1; k-- {
+		// The text between numeric levels must look like a separator.
+		if k > 1 && !isSeparator(seps[k-2]) {
+			continue
+		}
+		tails := inferModifier(seps[k-1] + strings.Join(interleave(digits[k:], seps[k:]), ""))
+		for _, levels := range inferLevels(nil, digits[:k]) {
+			var head strings.Builder
+			head.WriteString(prefix)
+			for i, con := range levels {
+				head.WriteString(con)
+				if i < k-1 {
+					head.WriteString(seps[i])
+				}
+			}
+			for _, tail := range tails {
+				// Convention names contain no square brackets, so the whole
+				// format can be escaped.
+				formats = append(formats, escapeLiteral(head.String()+tail))
+			}
+		}
+	}
+	return formats
+}
+
+// inferLevels returns the possible conventions of the runs of digits given the
+// convention of the previous run, which is nil for the first run.
+func inferLevels(prev *internal.Convention, runs []string) [][]string {
+	if len(runs) == 0 {
+		return [][]string{nil}
+	}
+	var res [][]string
+	for _, name := range inferConventions(prev, runs[0]) {
+		con := internal.Conventions[name]
+		for _, rest := range inferLevels(&con, runs[1:]) {
+			res = append(res, append([]string{name}, rest...))
+		}
+	}
+	return res
+}
+
+// inferConventions returns the conventions that may follow the previous
+// convention and whose values include value, most plausible first.
+func inferConventions(prev *internal.Convention, value string) []string {
+	var names []string
+	switch {
+	case prev == nil:
+		names = []string{"<YYYY>", "<0Y>", "<YY>", "<MAJOR>"}
+	case prev.Level == internal.KeyMajor && prev.Kind.IsCalendar():
+		names = []string{"<0M>", "<MM>", "<0W>", "<WW>"}
+	case prev.Level == internal.KeyMajor:
+		names = []string{"<MINOR>"}
+	case prev.Level == internal.KeyMinor && prev.Kind == internal.KindMonth && prev.Padded:
+		names = []string{"<0D>", "<MICRO>"}
+	case prev.Level == internal.KeyMinor && prev.Kind == internal.KindMonth:
+		names = []string{"<DD>", "<MICRO>"}
+	case prev.Level == internal.KeyMinor:
+		names = []string{"<MICRO>"}
+	}
+
+	var res []string
+	for _, name := range names {
+		if inferValue(internal.Conventions[name], value) {
+			res = append(res, name)
+		}
+	}
+	return res
+}
+
+// inferValue reports whether value is a plausible value of the convention.
+func inferValue(con internal.Convention, value string) bool {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return false
+	}
+	leadingZero := len(value) > 1 && value[0] == '0'
+	switch con.Kind {
+	case internal.KindYear:
+		return len(value) == 4 && n >= 1900 && n < 2200
+	case internal.KindShortYear:
+		if con.Padded {
+			return len(value) == 2
+		}
+		// Single digit years are more likely counters, e.g. 1.2.3.
+		return !leadingZero && n >= 10 && n < 100
+	case internal.KindMonth:
+		return inferPadding(con, value, leadingZero) && n >= 1 && n <= 12
+	case internal.KindWeek:
+		return inferPadding(con, value, leadingZero) && n >= 1 && n <= 53
+	case internal.KindDay:
+		return inferPadding(con, value, leadingZero) && n >= 1 && n <= 31
+	}
+	return true
+}
+
+// inferPadding reports whether value has the padding of the convention, i.e.
+// exactly two digits if it is padded and no leading zero otherwise.
+func inferPadding(con internal.Convention, value string, leadingZero bool) bool {
+	if con.Padded {
+		return len(value) == 2
+	}
+	return !leadingZero
+}
+
+// inferModifier returns the possible endings of a format given the text
+// following its last numeric level.
+func inferModifier(rest string) []string {
+	if rest == "" {
+		return []string{""}
+	}
+	sep := rest[:len(rest)-len(strings.TrimLeftFunc(rest, isPunct))]
+	if sep == "" && len(rest) > 1 && unicode.IsLetter(rune(rest[0])) && isDigit(rest[1]) {
+		// A single letter followed by digits such as the T of a timestamp.
+		sep = rest[:1]
+	}
+	body := rest[len(sep):]
+	if body == "" {
+		return []string{rest}
+	}
+	if !strings.ContainsFunc(body, isDigitRune) {
+		return []string{sep + "<MODIFIER>", rest}
+	}
+
+	tails := []string{sep + "<MODIFIER>"}
+	suffix := body[len(strings.TrimRightFunc(body, func(r rune) bool { return !isDigitRune(r) })):]
+	if suffix != "" && isSeparator(suffix) {
+		tails = append([]string{sep + "<MODIFIER>" + suffix}, tails...)
+	}
+	return tails
+}
+
+// isSeparator reports whether s looks like literal text between two values,
+// i.e. punctuation and at most one letter such as "-W" or "T".
+func isSeparator(s string) bool {
+	letters := 0
+	for _, r := range s {
+		switch {
+		case isDigitRune(r):
+			return false
+		case unicode.IsLetter(r):
+			letters++
+		}
+	}
+	return letters <= 1
+}
+
+// interleave returns a[0], b[0], a[1], b[1] and so on.
+func interleave(a, b []string) []string {
+	res := make([]string, 0, len(a)+len(b))
+	for i := range a {
+		res = append(res, a[i], b[i])
+	}
+	return res
+}
+
+func isPunct(r rune) bool {
+	return !unicode.IsLetter(r) && !isDigitRune(r)
+}
+
+func isDigitRune(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,59 @@
+package calver_test
+
+import (
+	"testing"
+
+	"github.com/shazib-summar/go-calver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInferFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		samples []string
+		want    string
+		wantErr bool
+	}{
+		{name: "1", samples: []string{"22.04.6"}, want: "<0Y>.<0M>.<MICRO>"},
+		{name: "2", samples: []string{"RELEASE.2025-07-23T15-54-02Z"}, want: "RELEASE.<YYYY>-<0M>-<0D>T<MODIFIER>Z"},
+		{name: "3", samples: []string{"2025.07.14"}, want: "<YYYY>.<0M>.<0D>"},
+		{name: "4", samples: []string{"2025.07.3", "2025.07.14"}, want: "<YYYY>.<0M>.<MICRO>"},
+		{name: "5", samples: []string{"2025.07.14", "2025.02.30"}, want: "<YYYY>.<0M>.<MICRO>"},
+		{name: "6", samples: []string{"1.2.3", "1.10.0"}, want: "<MAJOR>.<MINOR>.<MICRO>"},
+		{name: "7", samples: []string{"25.7.4"}, want: "<YY>.<MM>.<DD>"},
+		{name: "8", samples: []string{"2025-W29", "2025-W05"}, want: "<YYYY>-W<0W>"},
+		{name: "9", samples: []string{"2025.07.14-rc1", "2025.07.14-beta"}, want: "<YYYY>.<0M>.<0D>-<MODIFIER>"},
+		{name: "10", samples: []string{"v2025.07.1", "v2025.06.0", "nightly"}, want: "v<YYYY>.<0M>.<MICRO>"},
+		{name: "11", samples: []string{"Rel-2025-07-14"}, want: "Rel-<YYYY>-<0M>-<0D>"},
+		{name: "12", samples: []string{"2025.45"}, want: "<YYYY>.<0W>"},
+		{name: "13", samples: []string{"1234.07.14"}, want: "<MAJOR>.<MINOR>.<MICRO>"},
+		{name: "14", samples: []string{"latest", "nightly"}, wantErr: true},
+		{name: "15", samples: nil, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			formats, err := calver.InferFormat(test.samples...)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotEmpty(t, formats)
+			assert.Equal(t, test.want, formats[0])
+
+			// Every proposed format must be valid and match at least one sample.
+			for _, format := range formats {
+				f, err := calver.CompileFormat(format)
+				assert.NoError(t, err)
+				matched := false
+				for _, sample := range test.samples {
+					if _, err := f.Parse(sample); err == nil {
+						matched = true
+					}
+				}
+				assert.True(t, matched, format)
+			}
+		})
+	}
+}
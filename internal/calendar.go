@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"strconv"
+	"time"
+)
+
+// Year returns the full year represented by the value of a year convention.
+// Short years are relative to the year 2000. It reports false if the kind is
+// not a year or the value is not a number.
+func Year(kind Kind, value string) (int, bool) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+	switch kind {
+	case KindYear:
+		return n, true
+	case KindShortYear:
+		return 2000 + n, true
+	}
+	return 0, false
+}
+
+// DaysIn returns the number of days in the month of the year. If the year is
+// unknown, i.e. 0, February is assumed to have 29 days.
+func DaysIn(year, month int) int {
+	if year == 0 {
+		year = 2000
+	}
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// DaysInYear returns the number of days in the year, which is either 365 or
+// 366. If the year is unknown, i.e. 0, 366 is returned.
+func DaysInYear(year int) int {
+	if year == 0 {
+		return 366
+	}
+	return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+// ISOWeeksIn returns the number of ISO weeks in the year, which is either 52 or
+// 53. If the year is unknown, i.e. 0, 53 is returned.
+func ISOWeeksIn(year int) int {
+	if year == 0 {
+		return 53
+	}
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
+// ISOWeekStart returns the Monday starting the ISO week of the year.
+func ISOWeekStart(year, week int) time.Time {
+	// January 4th is always in the first ISO week of the year.
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	offset := (int(jan4.Weekday()) + 6) % 7
+	return jan4.AddDate(0, 0, (week-1)*7-offset)
+}
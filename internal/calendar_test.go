@@ -0,0 +1,116 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYear(t *testing.T) {
+	tests := []struct {
+		name   string
+		kind   Kind
+		value  string
+		want   int
+		wantOk bool
+	}{
+		{name: "1", kind: KindYear, value: "2025", want: 2025, wantOk: true},
+		{name: "2", kind: KindShortYear, value: "25", want: 2025, wantOk: true},
+		{name: "3", kind: KindShortYear, value: "05", want: 2005, wantOk: true},
+		{name: "4", kind: KindShortYear, value: "5", want: 2005, wantOk: true},
+		{name: "5", kind: KindCounter, value: "2025", want: 0, wantOk: false},
+		{name: "6", kind: KindYear, value: "abc", want: 0, wantOk: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := Year(test.kind, test.value)
+			assert.Equal(t, test.wantOk, ok)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestDaysIn(t *testing.T) {
+	tests := []struct {
+		name  string
+		year  int
+		month int
+		want  int
+	}{
+		{name: "1", year: 2025, month: 1, want: 31},
+		{name: "2", year: 2025, month: 2, want: 28},
+		{name: "3", year: 2024, month: 2, want: 29},
+		{name: "4", year: 1900, month: 2, want: 28},
+		{name: "5", year: 2000, month: 2, want: 29},
+		{name: "6", year: 2025, month: 4, want: 30},
+		{name: "7", year: 0, month: 2, want: 29},
+		{name: "8", year: 2025, month: 12, want: 31},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, DaysIn(test.year, test.month))
+		})
+	}
+}
+
+func TestISOWeeksIn(t *testing.T) {
+	tests := []struct {
+		name string
+		year int
+		want int
+	}{
+		{name: "1", year: 2025, want: 52},
+		{name: "2", year: 2026, want: 53},
+		{name: "3", year: 2020, want: 53},
+		{name: "4", year: 2021, want: 52},
+		{name: "5", year: 0, want: 53},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, ISOWeeksIn(test.year))
+		})
+	}
+}
+
+func TestDaysInYear(t *testing.T) {
+	tests := []struct {
+		name string
+		year int
+		want int
+	}{
+		{name: "1", year: 2025, want: 365},
+		{name: "2", year: 2024, want: 366},
+		{name: "3", year: 1900, want: 365},
+		{name: "4", year: 2000, want: 366},
+		{name: "5", year: 0, want: 366},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, DaysInYear(test.year))
+		})
+	}
+}
+
+func TestISOWeekStart(t *testing.T) {
+	tests := []struct {
+		name string
+		year int
+		week int
+		want string
+	}{
+		{name: "1", year: 2025, week: 1, want: "2024-12-30"},
+		{name: "2", year: 2025, week: 29, want: "2025-07-14"},
+		{name: "3", year: 2026, week: 53, want: "2026-12-28"},
+		{name: "4", year: 2021, week: 1, want: "2021-01-04"},
+		{name: "5", year: 2020, week: 53, want: "2020-12-28"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := ISOWeekStart(test.year, test.week)
+			assert.Equal(t, test.want, got.Format("2006-01-02"))
+			year, week := got.ISOWeek()
+			assert.Equal(t, test.year, year)
+			assert.Equal(t, test.week, week)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/samber/lo"
 )
@@ -14,9 +15,9 @@ const (
 )
 
 // ValidLevels is used to determine the order of the identifiers when comparing
-// two versions, so the order in this array matters. This is also used to
-// validate the format string. The format string can only have a single
-// convention of each level.
+// two versions, so the order in this array matters. A format string may have
+// several conventions of the same level, which are compared in the order they
+// appear in the format string.
 //
 // All the identifiers are compared as integers except for the modifier which is
 // compared as a string.
@@ -48,6 +49,9 @@ var ConventionsRegex = map[string]string{
 	// Minor
 	"<MM>":    fmt.Sprintf(`(?P<%s>\d{1,2})`, KeyMinor),
 	"<0M>":    fmt.Sprintf(`(?P<%s>\d{2})`, KeyMinor),
+	"<Q>":     fmt.Sprintf(`(?P<%s>\d)`, KeyMinor),
+	"<DOY>":   fmt.Sprintf(`(?P<%s>\d{1,3})`, KeyMinor),
+	"<0DOY>":  fmt.Sprintf(`(?P<%s>\d{3})`, KeyMinor),
 	"<MINOR>": fmt.Sprintf(`(?P<%s>\d+)`, KeyMinor),
 
 	// Micro
@@ -55,12 +59,131 @@ var ConventionsRegex = map[string]string{
 	"<0W>":    fmt.Sprintf(`(?P<%s>\d{2})`, KeyMicro),
 	"<DD>":    fmt.Sprintf(`(?P<%s>\d{1,2})`, KeyMicro),
 	"<0D>":    fmt.Sprintf(`(?P<%s>\d{2})`, KeyMicro),
+	"<HH>":    fmt.Sprintf(`(?P<%s>\d{1,2})`, KeyMicro),
+	"<0H>":    fmt.Sprintf(`(?P<%s>\d{2})`, KeyMicro),
+	"<mm>":    fmt.Sprintf(`(?P<%s>\d{2})`, KeyMicro),
 	"<MICRO>": fmt.Sprintf(`(?P<%s>\d+)`, KeyMicro),
 
 	// Modifier
 	"<MODIFIER>": fmt.Sprintf(`(?P<%s>.*)`, KeyModifier),
 }
 
+// Kind describes what the value of a convention represents. It is used to
+// decide how the value is validated, incremented and converted to a date.
+type Kind int
+
+const (
+	// KindCounter is a plain number such as <MAJOR>, <MINOR> or <MICRO>.
+	KindCounter Kind = iota
+	// KindYear is a full year such as <YYYY>.
+	KindYear
+	// KindShortYear is a year relative to 2000 such as <YY> or <0Y>.
+	KindShortYear
+	// KindMonth is a month of the year such as <MM> or <0M>.
+	KindMonth
+	// KindWeek is an ISO week of the year such as <WW> or <0W>.
+	KindWeek
+	// KindDay is a day of the month such as <DD> or <0D>.
+	KindDay
+	// KindQuarter is a quarter of the year, <Q>.
+	KindQuarter
+	// KindDayOfYear is a day of the year such as <DOY> or <0DOY>.
+	KindDayOfYear
+	// KindHour is an hour of the day such as <HH> or <0H>.
+	KindHour
+	// KindMinute is a minute of the hour, <mm>.
+	KindMinute
+	// KindModifier is a free-form string such as <MODIFIER>.
+	KindModifier
+	// KindCustom is a convention registered by the user such as <BUILD>.
+	KindCustom
+)
+
+// IsCalendar reports whether the kind is derived from the calendar.
+func (k Kind) IsCalendar() bool {
+	switch k {
+	case KindYear, KindShortYear, KindMonth, KindWeek, KindDay,
+		KindQuarter, KindDayOfYear, KindHour, KindMinute:
+		return true
+	}
+	return false
+}
+
+// unit returns the kind identifying the part of the date the kind represents.
+// Years and short years represent the same part; every other calendar kind is
+// a part of its own, so a month may be combined with a week or a day of the
+// year, see Version.Time for how such combinations resolve to a date.
+func (k Kind) unit() Kind {
+	if k == KindShortYear {
+		return KindYear
+	}
+	return k
+}
+
+// Convention holds the metadata of a single convention.
+type Convention struct {
+	// Name is the convention as written in the format string e.g. <YYYY>.
+	Name string
+	// Level is the level the convention belongs to.
+	Level string
+	// Kind is what the value of the convention represents.
+	Kind Kind
+	// Padded reports whether the value is zero-padded to a fixed width.
+	Padded bool
+
+	// The following fields are only set for custom conventions.
+
+	// Regex matches the values of the convention. It has no capturing group
+	// named after a level.
+	Regex string
+	// Pattern is Regex anchored to match whole values.
+	Pattern *regexp.Regexp
+	// Initial is the value the convention is reset to.
+	Initial string
+	// Increment returns the value following the given value. If nil, values
+	// are incremented as numbers.
+	Increment func(value string) (string, error)
+	// Compare compares two values of the convention. If nil, values are
+	// compared like the built-in conventions of the same level.
+	Compare func(a, b string) int
+}
+
+// FixedWidth reports whether every value of the convention has the same number
+// of digits, e.g. <YYYY>, <0M> or <Q>, as opposed to <YY> or <MM>.
+func (c Convention) FixedWidth() bool {
+	return c.Padded || c.Kind == KindYear || c.Kind == KindQuarter
+}
+
+// Conventions is a map of conventions to their metadata.
+var Conventions = map[string]Convention{
+	// Major
+	"<YYYY>":  {Name: "<YYYY>", Level: KeyMajor, Kind: KindYear},
+	"<YY>":    {Name: "<YY>", Level: KeyMajor, Kind: KindShortYear},
+	"<0Y>":    {Name: "<0Y>", Level: KeyMajor, Kind: KindShortYear, Padded: true},
+	"<MAJOR>": {Name: "<MAJOR>", Level: KeyMajor, Kind: KindCounter},
+
+	// Minor
+	"<MM>":    {Name: "<MM>", Level: KeyMinor, Kind: KindMonth},
+	"<0M>":    {Name: "<0M>", Level: KeyMinor, Kind: KindMonth, Padded: true},
+	"<Q>":     {Name: "<Q>", Level: KeyMinor, Kind: KindQuarter},
+	"<DOY>":   {Name: "<DOY>", Level: KeyMinor, Kind: KindDayOfYear},
+	"<0DOY>":  {Name: "<0DOY>", Level: KeyMinor, Kind: KindDayOfYear, Padded: true},
+	"<MINOR>": {Name: "<MINOR>", Level: KeyMinor, Kind: KindCounter},
+
+	// Micro
+	"<WW>":    {Name: "<WW>", Level: KeyMicro, Kind: KindWeek},
+	"<0W>":    {Name: "<0W>", Level: KeyMicro, Kind: KindWeek, Padded: true},
+	"<DD>":    {Name: "<DD>", Level: KeyMicro, Kind: KindDay},
+	"<0D>":    {Name: "<0D>", Level: KeyMicro, Kind: KindDay, Padded: true},
+	"<HH>":    {Name: "<HH>", Level: KeyMicro, Kind: KindHour},
+	"<0H>":    {Name: "<0H>", Level: KeyMicro, Kind: KindHour, Padded: true},
+	"<mm>":    {Name: "<mm>", Level: KeyMicro, Kind: KindMinute, Padded: true},
+	"<MICRO>": {Name: "<MICRO>", Level: KeyMicro, Kind: KindCounter},
+
+	// Modifier
+	"<MODIFIER>": {Name: "<MODIFIER>", Level: KeyModifier, Kind: KindModifier},
+}
+
 // ConventionsByLevel groups the conventions by level.
 var ConventionsByLevel = map[string][]string{
 	KeyMajor: {
@@ -72,6 +195,9 @@ var ConventionsByLevel = map[string][]string{
 	KeyMinor: {
 		"<MM>",
 		"<0M>",
+		"<Q>",
+		"<DOY>",
+		"<0DOY>",
 		"<MINOR>",
 	},
 	KeyMicro: {
@@ -79,6 +205,9 @@ var ConventionsByLevel = map[string][]string{
 		"<0W>",
 		"<DD>",
 		"<0D>",
+		"<HH>",
+		"<0H>",
+		"<mm>",
 		"<MICRO>",
 	},
 	KeyModifier: {
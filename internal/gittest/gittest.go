@@ -0,0 +1,44 @@
+// Package gittest provides the git repositories used by the tests of the
+// gitver package and the calver command.
+package gittest
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+)
+
+// NewRepo creates a git repository with a single commit and the given tags. It
+// skips the test if git is not installed.
+func NewRepo(t testing.TB, tags ...string) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	dir := t.TempDir()
+	Git(t, dir, "init", "--quiet")
+	Git(t, dir, "commit", "--quiet", "--allow-empty", "--message", "initial commit")
+	for _, tag := range tags {
+		Git(t, dir, "tag", tag)
+	}
+	return dir
+}
+
+// Git runs git in dir with a configuration independent of the user's.
+func Git(t testing.TB, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	cmd.Env = append(
+		os.Environ(),
+		"GIT_CONFIG_GLOBAL=/dev/null",
+		"GIT_CONFIG_NOSYSTEM=1",
+		"GIT_AUTHOR_NAME=calver",
+		"GIT_AUTHOR_EMAIL=calver@example.com",
+		"GIT_COMMITTER_NAME=calver",
+		"GIT_COMMITTER_EMAIL=calver@example.com",
+	)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %v: %v\n%s", args, err, out)
+	}
+}
@@ -23,3 +23,12 @@ func IncWithPadding(in string) (string, error) {
 		return nextStr, nil
 	}
 }
+
+// ResetWithPadding returns start formatted with the same zero padding as in. If
+// in is not zero padded, start is returned without padding.
+func ResetWithPadding(in string, start int) string {
+	if len(in) > 1 && in[0] == '0' {
+		return fmt.Sprintf("%0*d", len(in), start)
+	}
+	return strconv.Itoa(start)
+}
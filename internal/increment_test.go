@@ -72,3 +72,24 @@ func TestIncWithPadding(t *testing.T) {
 		})
 	}
 }
+
+func TestResetWithPadding(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		start int
+		want  string
+	}{
+		{name: "1", in: "7", start: 0, want: "0"},
+		{name: "2", in: "07", start: 1, want: "01"},
+		{name: "3", in: "12", start: 1, want: "1"},
+		{name: "4", in: "0042", start: 0, want: "0000"},
+		{name: "5", in: "", start: 0, want: "0"},
+		{name: "6", in: "0", start: 1, want: "1"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, ResetWithPadding(test.in, test.start))
+		})
+	}
+}
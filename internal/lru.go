@@ -0,0 +1,70 @@
+package internal
+
+import (
+	"container/list"
+	"sync"
+)
+
+// LRU is a cache holding at most a fixed number of values. When it is full,
+// adding a value evicts the least recently used one. It is safe for concurrent
+// use by multiple goroutines.
+type LRU[V any] struct {
+	mu    sync.Mutex
+	size  int
+	order *list.List
+	items map[string]*list.Element
+}
+
+// lruEntry is the value of an element of LRU.order.
+type lruEntry[V any] struct {
+	key   string
+	value V
+}
+
+// NewLRU returns an empty cache holding at most size values.
+func NewLRU[V any](size int) *LRU[V] {
+	return &LRU[V]{
+		size:  size,
+		order: list.New(),
+		items: map[string]*list.Element{},
+	}
+}
+
+// Get returns the value cached for the key and marks it as the most recently
+// used.
+func (c *LRU[V]) Get(key string) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*lruEntry[V]).value, true
+}
+
+// Add caches the value for the key, evicting the least recently used value if
+// the cache is full.
+func (c *LRU[V]) Add(key string, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		el.Value.(*lruEntry[V]).value = value
+		c.order.MoveToFront(el)
+		return
+	}
+	c.items[key] = c.order.PushFront(&lruEntry[V]{key: key, value: value})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry[V]).key)
+	}
+}
+
+// Len returns the number of cached values.
+func (c *LRU[V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
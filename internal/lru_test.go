@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRU(t *testing.T) {
+	c := NewLRU[int](2)
+	c.Add("a", 1)
+	c.Add("b", 2)
+	got, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, got)
+
+	// b is the least recently used value.
+	c.Add("c", 3)
+	assert.Equal(t, 2, c.Len())
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	got, ok = c.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, 3, got)
+
+	c.Add("a", 4)
+	c.Add("d", 5)
+	got, ok = c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 4, got)
+	_, ok = c.Get("c")
+	assert.False(t, ok)
+	assert.Equal(t, 2, c.Len())
+}
@@ -0,0 +1,160 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Token is a piece of a format string. A token is either literal text, a
+// convention or the start or end of an optional section.
+type Token struct {
+	// Literal is the literal text of the token. It is empty for conventions.
+	Literal string
+	// Convention is the name of the convention e.g. <YYYY>. It is empty for
+	// literal text.
+	Convention string
+	// SectionStart and SectionEnd are set for the brackets enclosing an
+	// optional section, e.g. [.<MICRO>].
+	SectionStart bool
+	SectionEnd   bool
+	// Offset is the byte offset of the token in the format string.
+	Offset int
+}
+
+// IsConvention reports whether the token is a convention.
+func (t Token) IsConvention() bool {
+	return t.Convention != ""
+}
+
+// Tokenize splits the format string into literal and convention tokens.
+// Anything enclosed in angle brackets that is not a known convention, such as
+// <YYY>, is treated as literal text.
+//
+// A level may be used by several conventions, e.g. <0D> and <MICRO> in
+// <YYYY>.<0M>.<0D>.<MICRO>, but every part of the date, i.e. the year, quarter,
+// month, week, day of the year, day, hour or minute, may only be represented
+// once, so <YYYY> cannot be combined with <0Y> nor <0M> with <MM>.
+//
+// Text enclosed in square brackets, e.g. [.<MICRO>] in <YYYY>.<0M>.<0D>[.<MICRO>],
+// is an optional section. Sections may be nested and must contain at least one
+// convention. Literal brackets are written \[ and \].
+//
+// It returns a *FormatError if the format string is empty, contains no
+// convention, represents a part of the date more than once or has unbalanced
+// or empty sections.
+func Tokenize(format string) ([]Token, error) {
+	return TokenizeWith(format, nil)
+}
+
+// TokenizeWith is like Tokenize but also recognizes the given custom
+// conventions, keyed by their name.
+func TokenizeWith(format string, custom map[string]Convention) ([]Token, error) {
+	if format == "" {
+		return nil, &FormatError{Offset: -1, Reason: "format is empty"}
+	}
+
+	var tokens []Token
+	var literal strings.Builder
+	literalStart := 0
+	flush := func() {
+		if literal.Len() > 0 {
+			tokens = append(tokens, Token{Literal: literal.String(), Offset: literalStart})
+			literal.Reset()
+		}
+	}
+
+	seen := map[Kind]Token{}
+	conventions := 0
+	// sections holds the open sections along with the number of conventions
+	// seen before each of them.
+	type section struct{ offset, conventions int }
+	var sections []section
+	for i := 0; i < len(format); {
+		switch {
+		case format[i] == '\\' && i+1 < len(format) && (format[i+1] == '[' || format[i+1] == ']'):
+			if literal.Len() == 0 {
+				literalStart = i
+			}
+			literal.WriteByte(format[i+1])
+			i += 2
+			continue
+		case format[i] == '[':
+			flush()
+			sections = append(sections, section{offset: i, conventions: conventions})
+			tokens = append(tokens, Token{SectionStart: true, Offset: i})
+			i++
+			literalStart = i
+			continue
+		case format[i] == ']':
+			if len(sections) == 0 {
+				return nil, &FormatError{Offset: i, Reason: "unexpected ] outside of an optional section"}
+			}
+			open := sections[len(sections)-1]
+			sections = sections[:len(sections)-1]
+			if conventions == open.conventions {
+				return nil, &FormatError{Offset: open.offset, Reason: "optional section contains no convention"}
+			}
+			flush()
+			tokens = append(tokens, Token{SectionEnd: true, Offset: i})
+			i++
+			literalStart = i
+			continue
+		case format[i] == '<':
+			end := strings.IndexByte(format[i+1:], '>')
+			if end >= 0 {
+				name := format[i : i+end+2]
+				con, ok := Conventions[name]
+				if !ok {
+					con, ok = custom[name]
+				}
+				if ok {
+					unit := con.Kind.unit()
+					if prev, ok := seen[unit]; ok && unit.IsCalendar() {
+						return nil, &FormatError{
+							Offset: i,
+							Reason: fmt.Sprintf(
+								"convention %s represents the same part of the date as %s (offset %d)",
+								name, prev.Convention, prev.Offset,
+							),
+						}
+					}
+					flush()
+					tok := Token{Convention: name, Offset: i}
+					seen[unit] = tok
+					conventions++
+					tokens = append(tokens, tok)
+					i += len(name)
+					literalStart = i
+					continue
+				}
+			}
+		}
+		literal.WriteByte(format[i])
+		i++
+	}
+	flush()
+
+	if len(sections) > 0 {
+		return nil, &FormatError{Offset: sections[len(sections)-1].offset, Reason: "optional section is not closed"}
+	}
+	if conventions == 0 {
+		return nil, &FormatError{Offset: -1, Reason: "format contains no convention"}
+	}
+	return tokens, nil
+}
+
+// FormatError describes why a format string could not be tokenized.
+type FormatError struct {
+	// Offset is the byte offset in the format string at which the problem was
+	// found or -1 if the problem concerns the format string as a whole.
+	Offset int
+	// Reason describes the problem.
+	Reason string
+}
+
+func (e *FormatError) Error() string {
+	if e.Offset < 0 {
+		return e.Reason
+	}
+	return fmt.Sprintf("%s at offset %d", e.Reason, e.Offset)
+}
@@ -0,0 +1,203 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		want    []Token
+		wantErr bool
+	}{
+		{
+			name:   "1",
+			format: "<YYYY>.<0M>.<MICRO>",
+			want: []Token{
+				{Convention: "<YYYY>", Offset: 0},
+				{Literal: ".", Offset: 6},
+				{Convention: "<0M>", Offset: 7},
+				{Literal: ".", Offset: 11},
+				{Convention: "<MICRO>", Offset: 12},
+			},
+		},
+		{
+			name:   "2",
+			format: "v<MAJOR>+build<MICRO>",
+			want: []Token{
+				{Literal: "v", Offset: 0},
+				{Convention: "<MAJOR>", Offset: 1},
+				{Literal: "+build", Offset: 8},
+				{Convention: "<MICRO>", Offset: 14},
+			},
+		},
+		{
+			name:   "3",
+			format: "<MAJOR>-<YYY>-<MICRO>",
+			want: []Token{
+				{Convention: "<MAJOR>", Offset: 0},
+				{Literal: "-<YYY>-", Offset: 7},
+				{Convention: "<MICRO>", Offset: 14},
+			},
+		},
+		{
+			name:   "4",
+			format: "<<YYYY>>",
+			want: []Token{
+				{Literal: "<", Offset: 0},
+				{Convention: "<YYYY>", Offset: 1},
+				{Literal: ">", Offset: 7},
+			},
+		},
+		{
+			name:   "5",
+			format: "<YYYY><0M><0D>",
+			want: []Token{
+				{Convention: "<YYYY>", Offset: 0},
+				{Convention: "<0M>", Offset: 6},
+				{Convention: "<0D>", Offset: 10},
+			},
+		},
+		{name: "6", format: "", wantErr: true},
+		{name: "7", format: "foobar", wantErr: true},
+		{name: "8", format: "<YYYY>-<0Y>", wantErr: true},
+		{name: "9", format: "<YYY", wantErr: true},
+		{
+			name:   "10",
+			format: "<YYYY>.<0D>.<MICRO>",
+			want: []Token{
+				{Convention: "<YYYY>", Offset: 0},
+				{Literal: ".", Offset: 6},
+				{Convention: "<0D>", Offset: 7},
+				{Literal: ".", Offset: 11},
+				{Convention: "<MICRO>", Offset: 12},
+			},
+		},
+		{
+			name:   "11",
+			format: "<MAJOR>-<MAJOR>",
+			want: []Token{
+				{Convention: "<MAJOR>", Offset: 0},
+				{Literal: "-", Offset: 7},
+				{Convention: "<MAJOR>", Offset: 8},
+			},
+		},
+		{name: "12", format: "<0M>.<DD>.<MM>", wantErr: true},
+		{
+			name:   "13",
+			format: "<YYYY>.<0M>.<0D>[.<MICRO>]",
+			want: []Token{
+				{Convention: "<YYYY>", Offset: 0},
+				{Literal: ".", Offset: 6},
+				{Convention: "<0M>", Offset: 7},
+				{Literal: ".", Offset: 11},
+				{Convention: "<0D>", Offset: 12},
+				{SectionStart: true, Offset: 16},
+				{Literal: ".", Offset: 17},
+				{Convention: "<MICRO>", Offset: 18},
+				{SectionEnd: true, Offset: 25},
+			},
+		},
+		{
+			name:   "14",
+			format: "<YYYY>[.<0M>[.<0D>]]",
+			want: []Token{
+				{Convention: "<YYYY>", Offset: 0},
+				{SectionStart: true, Offset: 6},
+				{Literal: ".", Offset: 7},
+				{Convention: "<0M>", Offset: 8},
+				{SectionStart: true, Offset: 12},
+				{Literal: ".", Offset: 13},
+				{Convention: "<0D>", Offset: 14},
+				{SectionEnd: true, Offset: 18},
+				{SectionEnd: true, Offset: 19},
+			},
+		},
+		{
+			name:   "15",
+			format: `\[<YYYY>\]`,
+			want: []Token{
+				{Literal: "[", Offset: 0},
+				{Convention: "<YYYY>", Offset: 2},
+				{Literal: "]", Offset: 8},
+			},
+		},
+		{name: "16", format: "<YYYY>[.<0M>", wantErr: true},
+		{name: "17", format: "<YYYY>].<0M>", wantErr: true},
+		{name: "18", format: "<YYYY>[.]<0M>", wantErr: true},
+		{name: "19", format: "[<YYYY>][-<YY>]", wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Tokenize(test.format)
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.want, got)
+			}
+		})
+	}
+}
+
+func TestTokenizeWith(t *testing.T) {
+	custom := map[string]Convention{
+		"<BUILD>": {Name: "<BUILD>", Level: KeyMicro, Kind: KindCustom, Regex: `\d+`},
+		"<SHA>":   {Name: "<SHA>", Level: KeyModifier, Kind: KindCustom, Regex: `[0-9a-f]{7}`},
+	}
+	tests := []struct {
+		name    string
+		format  string
+		want    []Token
+		wantErr bool
+	}{
+		{
+			name:   "1",
+			format: "<YYYY>.<0M>.<BUILD>+<SHA>",
+			want: []Token{
+				{Convention: "<YYYY>", Offset: 0},
+				{Literal: ".", Offset: 6},
+				{Convention: "<0M>", Offset: 7},
+				{Literal: ".", Offset: 11},
+				{Convention: "<BUILD>", Offset: 12},
+				{Literal: "+", Offset: 19},
+				{Convention: "<SHA>", Offset: 20},
+			},
+		},
+		{
+			name:   "2",
+			format: "<BUILD>-<QQ>",
+			want: []Token{
+				{Convention: "<BUILD>", Offset: 0},
+				{Literal: "-<QQ>", Offset: 7},
+			},
+		},
+		{name: "3", format: "<YY>.<BUILD>.<YYYY>", wantErr: true},
+		{
+			name:   "4",
+			format: "<SHA>-<MODIFIER>",
+			want: []Token{
+				{Convention: "<SHA>", Offset: 0},
+				{Literal: "-", Offset: 5},
+				{Convention: "<MODIFIER>", Offset: 6},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := TokenizeWith(test.format, custom)
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.want, got)
+			}
+		})
+	}
+
+	_, err := Tokenize("<BUILD>")
+	assert.Error(t, err)
+}
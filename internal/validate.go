@@ -1,31 +1,10 @@
 package internal
 
-import (
-	"strings"
-
-	"github.com/samber/lo"
-)
-
 // ValidateFormat reports if the format string is valid.
 //
-// The format string is valid if it contains only one convention of each level
-// and contains at least one convention.
+// The format string is valid if it contains at least one convention and does
+// not represent a part of the date more than once, see Tokenize.
 func ValidateFormat(format string) bool {
-	if !lo.ContainsBy(ValidConventions, func(con string) bool {
-		return strings.Contains(format, con)
-	}) {
-		return false
-	}
-
-	for _, lv := range ValidLevels {
-		cons := ConventionsByLevel[lv]
-		matches := 0
-		for _, con := range cons {
-			matches += strings.Count(format, con)
-		}
-		if matches > 1 {
-			return false
-		}
-	}
-	return true
+	_, err := Tokenize(format)
+	return err == nil
 }
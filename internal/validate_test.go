@@ -15,12 +15,30 @@ func TestValidateFormat(t *testing.T) {
 		{name: "1", format: "<YYYY>-<MM>-<DD>", want: true},
 		{name: "2", format: "<YYYY>-<MM>-<DD>-<MM>", want: false},
 		{name: "2", format: "<YYYY>-<YYYY>", want: false},
-		{name: "3", format: "<MAJOR>-<MAJOR>", want: false},
+		// Several conventions of the same level, such as two counters, were
+		// rejected before multiple conventions per level were supported.
+		{name: "3", format: "<MAJOR>-<MAJOR>", want: true},
 		{name: "4", format: "<MAJOR>-<MINOR>-<MICRO>", want: true},
-		{name: "5", format: "<MAJOR>-<MINOR>-<MICRO>-<MICRO>", want: false},
+		{name: "5", format: "<MAJOR>-<MINOR>-<MICRO>-<MICRO>", want: true},
 		{name: "6", format: "foobar", want: false},
 		{name: "7", format: "foobar-<MICRO>", want: true},
 		{name: "8", format: "foobar-<YYYY>", want: true},
+		{name: "9", format: "<YYYY>Q<Q>", want: true},
+		{name: "10", format: "<YYYY>.<0DOY>", want: true},
+		{name: "11", format: "<YYYY>.<DOY>.<0H><mm>", want: true},
+		{name: "12", format: "<YYYY>.<0M>.<HH>", want: true},
+		{name: "13", format: "<YYYY>.<0M>.<0D>.<0H>", want: true},
+		{name: "14", format: "<YYYY>.<0M>.<Q>", want: true},
+		{name: "15", format: "<YYYY>.<0H><mm>-<MODIFIER>", want: true},
+		{name: "16", format: "<YYYY>.<0M>.<0D>.<MICRO>", want: true},
+		{name: "17", format: "<YYYY>-W<0W>.<DOY>", want: true},
+		{name: "18", format: "<0Y>.<MAJOR>.<YY>", want: false},
+		{name: "19", format: "<YYYY>.<0M>.<0W>", want: true},
+		{name: "20", format: "<YYYY>.<DOY>.<DD>", want: true},
+		{name: "21", format: "<YYYY>.<0M>.<MM>", want: false},
+		{name: "22", format: "<YYYY>.<0DOY>.<DOY>", want: false},
+		{name: "23", format: "<YYYY>.<0H>.<HH>", want: false},
+		{name: "24", format: "<YYYY>.<0W>.<WW>", want: false},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
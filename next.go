@@ -0,0 +1,175 @@
+package calver
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shazib-summar/go-calver/internal"
+)
+
+type nextOptions struct {
+	modifier string
+}
+
+type nextOption func(*nextOptions)
+
+// WithNextModifier is a next option that sets the modifier of the version
+// returned by Version.Next. It is required if the format has a modifier that
+// is neither numeric nor in an optional section.
+//
+// Example:
+//
+//	ver, err := calver.Parse("<YYYY>.<0M>.<MICRO>-<MODIFIER>", "2025.07.3-rc1")
+//	if err != nil {
+//	    return err
+//	}
+//	next, err := ver.Next(time.Now(), calver.WithNextModifier("rc1"))
+func WithNextModifier(modifier string) nextOption {
+	return func(options *nextOptions) {
+		options.modifier = modifier
+	}
+}
+
+// Next returns the version that should be released at the given time. The
+// version itself is not modified. Passing the time explicitly, rather than
+// reading the clock, keeps the result deterministic in tests.
+//
+// The calendar levels, e.g. <YYYY> or <0M>, are set from now. If they are
+// unchanged, the first counter level, i.e. <MAJOR>, <MINOR> or <MICRO>, is
+// incremented and the counter levels below it are reset to 0. Otherwise every
+// counter level is reset to 0. Unless the WithNextModifier option is used, a
+// modifier in an optional section is cleared along with its section, e.g.
+// 2025.07.3-rc1 becomes 2025.07.4 with the format
+// <YYYY>.<0M>.<MICRO>[-<MODIFIER>], and a numeric modifier restarts at 0. A
+// pre-release tag such as rc1 is never carried over to the next version, so a
+// required modifier that is not numeric must be given with WithNextModifier.
+//
+// If the format has no counter level but has a numeric modifier, the modifier
+// is used as the counter: it is incremented when the calendar levels are
+// unchanged and restarts at 0 otherwise.
+//
+// Counters that are not the first convention of their level, such as the
+// build counter of <YYYY>.<0M>.<0D>.<MICRO>, are counters like any other.
+// Counters in optional sections are cleared instead of being reset to 0 and an
+// absent one counts as 0, so with the format <YYYY>.<0M>.<0D>[.<MICRO>] the
+// version following 2025.07.14 on the same day is 2025.07.14.1 and on the next
+// day 2025.07.15.
+//
+// Custom conventions, see Registry, are reset to their initial value. If they
+// have an Increment function they are counters like <MICRO>. A custom modifier
+// without an initial value is handled like <MODIFIER>.
+//
+// Example:
+//
+//	ver, err := calver.Parse("<YYYY>.<0M>.<MICRO>", "2025.07.3")
+//	if err != nil {
+//	    return err
+//	}
+//	next, err := ver.Next(time.Date(2025, time.July, 20, 0, 0, 0, 0, time.UTC))
+//	fmt.Println(next.String()) // 2025.07.4
+//	next, err = ver.Next(time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC))
+//	fmt.Println(next.String()) // 2025.08.0
+//
+// It returns an error wrapping ErrClockBehind if the calendar levels of the
+// version are later than now, ErrNoCounter if the calendar levels are
+// unchanged but there is nothing to increment and ErrNoModifier if the format
+// requires a modifier that is not given with WithNextModifier.
+func (c *Version) Next(now time.Time, opts ...nextOption) (*Version, error) {
+	o := &nextOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	f, err := c.compiledFormat()
+	if err != nil {
+		return nil, err
+	}
+	fresh, err := f.FromTime(now)
+	if err != nil {
+		return nil, err
+	}
+
+	res := compareCalendar(c, fresh, f)
+	if res > 0 {
+		return nil, fmt.Errorf(
+			"next version of %q at %s: %w",
+			c.String(), now.Format(time.DateOnly), ErrClockBehind,
+		)
+	}
+
+	next := &Version{Format: f.raw, format: f}
+	counted := false
+	for i, seg := range f.segments {
+		con := seg.convention
+		switch {
+		case con.Kind.IsCalendar():
+			next.setSegmentValue(f, i, fresh.segmentValue(f, i))
+		case con.Kind == internal.KindCounter:
+			value := c.segmentValue(f, i)
+			switch {
+			case res == 0 && !counted:
+				value, err = internal.IncWithPadding(f.optionalValue(i, value))
+				if err != nil {
+					return nil, err
+				}
+				counted = true
+			case seg.optional:
+				value = ""
+			default:
+				value = internal.ResetWithPadding(value, 0)
+			}
+			next.setSegmentValue(f, i, value)
+		case con.Kind == internal.KindCustom && con.Level != internal.KeyModifier:
+			value := con.Initial
+			if res == 0 && !counted && con.Increment != nil {
+				value, err = incValue(con, c.segmentValue(f, i))
+				if err != nil {
+					return nil, err
+				}
+				counted = true
+			}
+			next.setSegmentValue(f, i, value)
+		}
+	}
+
+	next.Modifier = o.modifier
+	if i, ok := f.levels[internal.KeyModifier]; ok && o.modifier == "" {
+		_, err := internal.IncWithPadding(c.Modifier)
+		numeric := err == nil
+		switch {
+		case numeric && !counted:
+			// Without a counter level, a numeric modifier is the counter.
+			next.Modifier = internal.ResetWithPadding(c.Modifier, 0)
+			if res == 0 {
+				next.Modifier, _ = internal.IncWithPadding(c.Modifier)
+			}
+			counted = true
+		case f.segments[i].optional:
+			// The modifier is cleared along with its section.
+		case f.segments[i].convention.Initial != "":
+			next.Modifier = f.segments[i].convention.Initial
+		case numeric:
+			next.Modifier = internal.ResetWithPadding(c.Modifier, 0)
+		default:
+			return nil, fmt.Errorf("next version of %q: %w", c.String(), ErrNoModifier)
+		}
+	}
+	if res == 0 && !counted {
+		return nil, fmt.Errorf("next version of %q: %w", c.String(), ErrNoCounter)
+	}
+	return next, nil
+}
+
+// compareCalendar compares the calendar segments of the versions which must
+// both be of the format f.
+func compareCalendar(a, b *Version, f *Format) int {
+	for i, seg := range f.segments {
+		if !seg.convention.Kind.IsCalendar() {
+			continue
+		}
+		if res := compareStringInt(a.segmentValue(f, i), b.segmentValue(f, i)); res != 0 {
+			return res
+		}
+	}
+	return 0
+}
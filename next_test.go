@@ -0,0 +1,112 @@
+package calver_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shazib-summar/go-calver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionNext(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		version  string
+		now      time.Time
+		modifier string
+		want     string
+		wantErr  error
+	}{
+		{name: "1", format: "<YYYY>.<0M>.<MICRO>", version: "2025.07.3", now: date(2025, 8, 2), want: "2025.08.0"},
+		{name: "2", format: "<YYYY>.<0M>.<MICRO>", version: "2025.07.3", now: date(2025, 7, 20), want: "2025.07.4"},
+		{name: "3", format: "<YYYY>.<0M>.<MICRO>", version: "2025.12.9", now: date(2026, 1, 1), want: "2026.01.0"},
+		{name: "4", format: "<YYYY>.<MINOR>.<MICRO>", version: "2025.3.7", now: date(2025, 7, 20), want: "2025.4.0"},
+		{name: "5", format: "<YYYY>.<MINOR>.<MICRO>", version: "2025.3.7", now: date(2026, 7, 20), want: "2026.0.0"},
+		{name: "6", format: "<0Y>.<0M>.<MICRO>", version: "25.07.09", now: date(2025, 8, 2), want: "25.08.00"},
+		{name: "7", format: "<YYYY>.<0M>.<MICRO>-<MODIFIER>", version: "2025.07.3-rc1", now: date(2025, 7, 20), wantErr: calver.ErrNoModifier},
+		{
+			name:     "8",
+			format:   "<YYYY>.<0M>.<MICRO>-<MODIFIER>",
+			version:  "2025.07.3-rc1",
+			now:      date(2025, 8, 2),
+			modifier: "rc1",
+			want:     "2025.08.0-rc1",
+		},
+		{name: "9", format: "<YYYY>.<0M>.<0D>-<MODIFIER>", version: "2025.07.14-3", now: date(2025, 7, 14), want: "2025.07.14-4"},
+		{name: "10", format: "<YYYY>.<0M>.<0D>-<MODIFIER>", version: "2025.07.14-3", now: date(2025, 7, 15), want: "2025.07.15-0"},
+		{name: "11", format: "<YYYY>.<0M>.<0D>", version: "2025.07.14", now: date(2025, 7, 15), want: "2025.07.15"},
+		{name: "12", format: "<YYYY>.<0M>.<0D>", version: "2025.07.14", now: date(2025, 7, 14), wantErr: calver.ErrNoCounter},
+		{name: "13", format: "<YYYY>.<0M>.<MICRO>", version: "2025.07.3", now: date(2025, 6, 30), wantErr: calver.ErrClockBehind},
+		{name: "14", format: "<YYYY>-W<0W>-<MODIFIER>", version: "2025-W01-2", now: date(2024, 12, 31), want: "2025-W01-3"},
+		{name: "15", format: "<MAJOR>.<MINOR>", version: "1.2", now: date(2025, 7, 14), want: "2.0"},
+		{name: "16", format: "<YYYY>Q<Q>.<MICRO>", version: "2025Q3.2", now: date(2025, 9, 30), want: "2025Q3.3"},
+		{name: "17", format: "<YYYY>Q<Q>.<MICRO>", version: "2025Q3.2", now: date(2025, 10, 1), want: "2025Q4.0"},
+		{
+			name:     "18",
+			format:   "<YYYY>.<0DOY>.<0H>.<mm>",
+			version:  "2025.195.09.05",
+			now:      time.Date(2025, 7, 14, 18, 30, 0, 0, time.UTC),
+			modifier: "rc1",
+			want:     "2025.195.18.30",
+		},
+		{
+			name:    "19",
+			format:  "<YYYY>.<0DOY>.<0H>.<mm>",
+			version: "2025.195.09.05",
+			now:     time.Date(2025, 7, 14, 9, 5, 0, 0, time.UTC),
+			wantErr: calver.ErrNoCounter,
+		},
+		{name: "20", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.07.14.3", now: date(2025, 7, 14), want: "2025.07.14.4"},
+		{name: "21", format: "<YYYY>.<0M>.<0D>.<MICRO>", version: "2025.07.14.3", now: date(2025, 7, 15), want: "2025.07.15.0"},
+		{name: "22", format: "<YYYY>.<MAJOR>.<MINOR>", version: "2025.3.1", now: date(2025, 7, 15), want: "2025.4.0"},
+		{
+			name:    "23",
+			format:  "<YYYY>.<0M>.<0D>.<0H><mm>",
+			version: "2025.07.14.0930",
+			now:     time.Date(2025, 7, 14, 18, 5, 0, 0, time.UTC),
+			want:    "2025.07.14.1805",
+		},
+		{name: "24", format: "<YYYY>.<0M>.<0D>[.<MICRO>]", version: "2025.07.14", now: date(2025, 7, 14), want: "2025.07.14.1"},
+		{name: "25", format: "<YYYY>.<0M>.<0D>[.<MICRO>]", version: "2025.07.14.1", now: date(2025, 7, 14), want: "2025.07.14.2"},
+		{name: "26", format: "<YYYY>.<0M>.<0D>[.<MICRO>]", version: "2025.07.14.2", now: date(2025, 7, 15), want: "2025.07.15"},
+		{name: "27", format: "<YYYY>.<0M>[.<0D>]", version: "2025.07", now: date(2025, 7, 15), want: "2025.07.15"},
+		{name: "28", format: "<YYYY>.<0M>.<MICRO>[-<MODIFIER>]", version: "2025.07.3-rc1", now: date(2025, 7, 20), want: "2025.07.4"},
+		{
+			name:     "29",
+			format:   "<YYYY>.<0M>.<MICRO>[-<MODIFIER>]",
+			version:  "2025.07.3",
+			now:      date(2025, 8, 2),
+			modifier: "rc1",
+			want:     "2025.08.0-rc1",
+		},
+		{name: "30", format: "<YYYY>.<0M>.<0W>", version: "2024.12.01", now: date(2025, 1, 6), want: "2025.01.02"},
+		{name: "31", format: "<YYYY>.<0M>.<MICRO>-<MODIFIER>", version: "2025.07.3-2", now: date(2025, 7, 20), want: "2025.07.4-0"},
+		{name: "32", format: "<YYYY>.<0M>.<MICRO>-<MODIFIER>", version: "2025.07.3-rc1", now: date(2025, 8, 2), wantErr: calver.ErrNoModifier},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ver, err := calver.Parse(test.format, test.version)
+			assert.NoError(t, err)
+
+			var next *calver.Version
+			if test.modifier != "" {
+				next, err = ver.Next(test.now, calver.WithNextModifier(test.modifier))
+			} else {
+				next, err = ver.Next(test.now)
+			}
+			if test.wantErr != nil {
+				assert.ErrorIs(t, err, test.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, next.String())
+			assert.Equal(t, test.version, ver.String())
+
+			reparsed, err := calver.Parse(test.format, next.String())
+			assert.NoError(t, err)
+			assert.Equal(t, next.String(), reparsed.String())
+		})
+	}
+}
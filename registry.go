@@ -0,0 +1,225 @@
+package calver
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/shazib-summar/go-calver/internal"
+)
+
+// CustomConvention describes a convention that is not part of the CalVer
+// specification, such as a build number or a commit hash. Custom conventions
+// are registered with a Registry and can be used in the formats compiled by
+// that registry like any built-in convention.
+type CustomConvention struct {
+	// Name is the convention as written in the format string. It must be
+	// enclosed in angle brackets, e.g. <BUILD>.
+	Name string
+	// Level is the level the convention belongs to, one of "major", "minor",
+	// "micro" or "modifier". It determines the significance of the convention
+	// when versions are compared, see Version.Segments.
+	Level string
+	// Regex matches the values of the convention, e.g. `\d+` or
+	// `[0-9a-f]{7}`. It must not contain named capturing groups.
+	Regex string
+	// Initial is the value the convention is reset to, e.g. by IncLevel with
+	// the ResetLower option, by Next or by Format.FromTime. It must match
+	// Regex unless it is empty. A modifier without an initial value is kept
+	// by IncLevel with the ResetLower option and must be given to Next with
+	// WithNextModifier.
+	Initial string
+	// Increment returns the value following the given value. It is used by
+	// IncLevel and, for levels other than the modifier, by Next, which return
+	// an error wrapping ErrInvalidConvention if the returned value does not
+	// match Regex. If nil, values are incremented as numbers keeping their zero
+	// padding.
+	Increment func(value string) (string, error)
+	// Compare returns 0 if a and b are equal, -1 if a is less than b and 1 if
+	// a is greater than b. If nil, or if only one of the compared versions
+	// uses the convention, values are compared like the built-in conventions
+	// of the same level.
+	Compare func(a, b string) int
+}
+
+// Registry holds custom conventions and compiles formats that use them. Each
+// registry is independent of the others and of the package level functions
+// such as Parse and CompileFormat, which only know the built-in conventions.
+//
+// A Registry is safe for concurrent use by multiple goroutines.
+//
+// Example:
+//
+//	r := calver.NewRegistry()
+//	err := r.Register(calver.CustomConvention{
+//	    Name:    "<BUILD>",
+//	    Level:   "micro",
+//	    Regex:   `\d+`,
+//	    Initial: "1",
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	f, err := r.CompileFormat("<YYYY>.<0M>+<BUILD>")
+//	if err != nil {
+//	    return err
+//	}
+//	ver, err := f.Parse("2025.07+118")
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(ver.Micro) // 118
+type Registry struct {
+	mu sync.RWMutex
+	// conventions is replaced rather than modified when a convention is
+	// registered so that compiled formats can share it.
+	conventions map[string]internal.Convention
+	// generation is incremented every time a convention is registered.
+	generation int
+	formats    map[string]*Format
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		conventions: map[string]internal.Convention{},
+		formats:     map[string]*Format{},
+	}
+}
+
+// Register adds a custom convention to the registry. Formats compiled before
+// the convention was registered are not affected.
+//
+// It returns an error wrapping ErrInvalidConvention if the name is not enclosed
+// in angle brackets or is already used by a built-in or registered convention,
+// if the level is unknown, if the regex is invalid or contains named capturing
+// groups, or if the initial value does not match the regex.
+func (r *Registry) Register(c CustomConvention) error {
+	invalid := func(reason string, args ...any) error {
+		return fmt.Errorf(
+			"registering convention %q: %s: %w",
+			c.Name, fmt.Sprintf(reason, args...), ErrInvalidConvention,
+		)
+	}
+
+	inner, hasPrefix := strings.CutPrefix(c.Name, "<")
+	inner, hasSuffix := strings.CutSuffix(inner, ">")
+	if !hasPrefix || !hasSuffix || inner == "" || strings.ContainsAny(inner, "<>") {
+		return invalid("name must be enclosed in angle brackets")
+	}
+	if _, ok := internal.Conventions[c.Name]; ok {
+		return invalid("name is used by a built-in convention")
+	}
+	level := strings.ToLower(c.Level)
+	if !slices.Contains(internal.ValidLevels, level) {
+		return invalid("level %q is not one of %s", c.Level, strings.Join(internal.ValidLevels, ", "))
+	}
+	if c.Regex == "" {
+		return invalid("regex is empty")
+	}
+	// The regex is compiled on its own first so that unbalanced parentheses
+	// cannot close the group it is wrapped in.
+	if _, err := regexp.Compile(c.Regex); err != nil {
+		return invalid("%v", err)
+	}
+	re, err := regexp.Compile(`^(?:` + c.Regex + `)$`)
+	if err != nil {
+		return invalid("%v", err)
+	}
+	for _, name := range re.SubexpNames() {
+		if name != "" {
+			return invalid("regex must not contain named groups")
+		}
+	}
+	if c.Initial != "" && !re.MatchString(c.Initial) {
+		return invalid("initial value %q does not match the regex", c.Initial)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.conventions[c.Name]; ok {
+		return invalid("name is already registered")
+	}
+	conventions := maps.Clone(r.conventions)
+	conventions[c.Name] = internal.Convention{
+		Name:      c.Name,
+		Level:     level,
+		Kind:      internal.KindCustom,
+		Regex:     c.Regex,
+		Pattern:   re,
+		Initial:   c.Initial,
+		Increment: c.Increment,
+		Compare:   c.Compare,
+	}
+	r.conventions = conventions
+	r.generation++
+	r.formats = map[string]*Format{}
+	return nil
+}
+
+// CompileFormat is like the package level CompileFormat but also recognizes
+// the conventions registered with the registry. Compiled formats are cached
+// by the registry.
+func (r *Registry) CompileFormat(format string) (*Format, error) {
+	r.mu.RLock()
+	f, ok := r.formats[format]
+	conventions, generation := r.conventions, r.generation
+	r.mu.RUnlock()
+	if ok {
+		return f, nil
+	}
+
+	f, err := compileFormat(format, conventions)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// Only cache the format if no convention was registered meanwhile.
+	if r.generation == generation {
+		r.formats[format] = f
+	}
+	return f, nil
+}
+
+// MustCompileFormat is like CompileFormat but panics if the format string is
+// invalid.
+func (r *Registry) MustCompileFormat(format string) *Format {
+	f, err := r.CompileFormat(format)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// compileFormats compiles the format strings using the registry.
+func (r *Registry) compileFormats(formats []string) ([]*Format, error) {
+	compiled := make([]*Format, 0, len(formats))
+	for _, format := range formats {
+		f, err := r.CompileFormat(format)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, f)
+	}
+	return compiled, nil
+}
+
+// WithRegistry is a parse option that compiles the formats given to WithFormat
+// using the registry, so they may use its custom conventions.
+//
+// Example:
+//
+//	ver, err := calver.ParseWithOptions(
+//	    "2025.07+118",
+//	    calver.WithFormat("<YYYY>.<0M>+<BUILD>"),
+//	    calver.WithRegistry(r),
+//	)
+func WithRegistry(r *Registry) parseOption {
+	return func(options *parseOptions) {
+		options.registry = r
+	}
+}
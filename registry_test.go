@@ -0,0 +1,276 @@
+package calver_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/shazib-summar/go-calver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistryRegister(t *testing.T) {
+	tests := []struct {
+		name       string
+		convention calver.CustomConvention
+		wantErr    bool
+	}{
+		{name: "1", convention: calver.CustomConvention{Name: "<BUILD>", Level: "micro", Regex: `\d+`}},
+		{name: "2", convention: calver.CustomConvention{Name: "<SHA>", Level: "Modifier", Regex: `[0-9a-f]{7}`}},
+		{name: "3", convention: calver.CustomConvention{Name: "<QQ>", Level: "minor", Regex: `0[1-4]`, Initial: "01"}},
+		{name: "4", convention: calver.CustomConvention{Name: "BUILD", Level: "micro", Regex: `\d+`}, wantErr: true},
+		{name: "5", convention: calver.CustomConvention{Name: "<>", Level: "micro", Regex: `\d+`}, wantErr: true},
+		{name: "6", convention: calver.CustomConvention{Name: "<B<U>", Level: "micro", Regex: `\d+`}, wantErr: true},
+		{name: "7", convention: calver.CustomConvention{Name: "<MICRO>", Level: "micro", Regex: `\d+`}, wantErr: true},
+		{name: "8", convention: calver.CustomConvention{Name: "<BUILD>", Level: "patch", Regex: `\d+`}, wantErr: true},
+		{name: "9", convention: calver.CustomConvention{Name: "<BUILD>", Level: "micro"}, wantErr: true},
+		{name: "10", convention: calver.CustomConvention{Name: "<BUILD>", Level: "micro", Regex: `(\d+`}, wantErr: true},
+		{name: "11", convention: calver.CustomConvention{Name: "<BUILD>", Level: "micro", Regex: `(?P<micro>\d+)`}, wantErr: true},
+		{name: "12", convention: calver.CustomConvention{Name: "<BUILD>", Level: "micro", Regex: `\d+`, Initial: "x"}, wantErr: true},
+		{name: "13", convention: calver.CustomConvention{Name: "<BUILD>", Level: "micro", Regex: `\d+)|(?:.*`}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := calver.NewRegistry().Register(test.convention)
+			if test.wantErr {
+				assert.ErrorIs(t, err, calver.ErrInvalidConvention)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	r := calver.NewRegistry()
+	assert.NoError(t, r.Register(calver.CustomConvention{Name: "<BUILD>", Level: "micro", Regex: `\d+`}))
+	err := r.Register(calver.CustomConvention{Name: "<BUILD>", Level: "micro", Regex: `\d+`})
+	assert.ErrorIs(t, err, calver.ErrInvalidConvention)
+}
+
+func TestRegistryParse(t *testing.T) {
+	r := calver.NewRegistry()
+	assert.NoError(t, r.Register(calver.CustomConvention{Name: "<BUILD>", Level: "micro", Regex: `\d+`}))
+	assert.NoError(t, r.Register(calver.CustomConvention{Name: "<SHA>", Level: "modifier", Regex: `[0-9a-f]{7}`}))
+
+	tests := []struct {
+		name         string
+		format       string
+		version      string
+		wantMicro    string
+		wantModifier string
+		wantErr      bool
+	}{
+		{name: "1", format: "<YYYY>.<0M>+<BUILD>", version: "2025.07+118", wantMicro: "118"},
+		{name: "2", format: "<YYYY>.<0M>.<BUILD>-<SHA>", version: "2025.07.3-1a2b3c4", wantMicro: "3", wantModifier: "1a2b3c4"},
+		{name: "3", format: "<YYYY>.<0M>.<BUILD>-<SHA>", version: "2025.07.3-1a2b3cz", wantErr: true},
+		{name: "4", format: "<YYYY>.<0M>+<BUILD>", version: "2025.07+", wantErr: true},
+		{name: "5", format: "<YYYY>.<0D>+<BUILD>", version: "2025.07+1x", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ver, err := calver.ParseWithOptions(
+				test.version,
+				calver.WithFormat(test.format),
+				calver.WithRegistry(r),
+			)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantMicro, ver.Micro)
+			assert.Equal(t, test.wantModifier, ver.Modifier)
+			assert.Equal(t, test.version, ver.String())
+			assert.Equal(t, test.format, ver.Format)
+		})
+	}
+
+	// The package level functions only know the built-in conventions.
+	_, err := calver.Parse("<YYYY>.<0M>+<BUILD>", "2025.07+118")
+	assert.Error(t, err)
+}
+
+func TestRegistryIsolation(t *testing.T) {
+	hex := calver.NewRegistry()
+	assert.NoError(t, hex.Register(calver.CustomConvention{Name: "<ID>", Level: "modifier", Regex: `[0-9a-f]+`}))
+	num := calver.NewRegistry()
+	assert.NoError(t, num.Register(calver.CustomConvention{Name: "<ID>", Level: "modifier", Regex: `\d+`}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := hex.MustCompileFormat("<YYYY>-<ID>").Parse("2025-beef")
+			assert.NoError(t, err)
+			_, err = num.MustCompileFormat("<YYYY>-<ID>").Parse("2025-beef")
+			assert.Error(t, err)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRegistryIncrementCompare(t *testing.T) {
+	quarters := []string{"Q1", "Q2", "Q3", "Q4"}
+	r := calver.NewRegistry()
+	assert.NoError(t, r.Register(calver.CustomConvention{
+		Name:    "<QQ>",
+		Level:   "minor",
+		Regex:   `Q[1-4]`,
+		Initial: "Q1",
+		Increment: func(value string) (string, error) {
+			for i, q := range quarters[:len(quarters)-1] {
+				if q == value {
+					return quarters[i+1], nil
+				}
+			}
+			return "", fmt.Errorf("cannot increment quarter %q", value)
+		},
+	}))
+	assert.NoError(t, r.Register(calver.CustomConvention{
+		Name:  "<CHANNEL>",
+		Level: "modifier",
+		Regex: `alpha|beta|stable`,
+		Compare: func(a, b string) int {
+			rank := map[string]int{"alpha": 0, "beta": 1, "stable": 2}
+			return rank[a] - rank[b]
+		},
+	}))
+	f := r.MustCompileFormat("<YYYY>-<QQ>.<MICRO>-<CHANNEL>")
+
+	ver := f.MustParse("2025-Q2.7-beta")
+	assert.NoError(t, ver.IncLevel("minor", calver.BumpOptions{}))
+	assert.Equal(t, "2025-Q3.7-beta", ver.String())
+	assert.Error(t, f.MustParse("2025-Q4.0-beta").IncLevel("minor", calver.BumpOptions{}))
+
+	ver = f.MustParse("2025-Q3.7-beta")
+	assert.NoError(t, ver.IncLevel("major", calver.BumpOptions{ResetLower: true}))
+	assert.Equal(t, "2026-Q1.0-beta", ver.String())
+
+	collection, err := calver.NewCollectionWithOptions(
+		[]string{"2025-Q2.0-stable", "2025-Q2.0-alpha", "2025-Q1.3-stable", "2025-Q2.0-beta"},
+		calver.WithFormat("<YYYY>-<QQ>.<MICRO>-<CHANNEL>"),
+		calver.WithRegistry(r),
+	)
+	assert.NoError(t, err)
+	collection.SortWith()
+	var got []string
+	for _, ver := range collection {
+		got = append(got, ver.String())
+	}
+	assert.Equal(t, []string{"2025-Q1.3-stable", "2025-Q2.0-alpha", "2025-Q2.0-beta", "2025-Q2.0-stable"}, got)
+}
+
+func TestRegistryCompareBuiltIn(t *testing.T) {
+	r := calver.NewRegistry()
+	assert.NoError(t, r.Register(calver.CustomConvention{
+		Name:  "<CHANNEL>",
+		Level: "modifier",
+		Regex: `dev|alpha`,
+		Compare: func(a, b string) int {
+			rank := map[string]int{"dev": 0, "alpha": 1}
+			return rank[a] - rank[b]
+		},
+	}))
+	f := r.MustCompileFormat("<YYYY>.<0M>-<CHANNEL>")
+	custom := f.MustParse("2025.07-dev")
+	plain := calver.MustCompileFormat("<YYYY>.<0M>-<MODIFIER>").MustParse("2025.07-alpha")
+
+	// Without the convention on both sides, the modifiers compare as strings.
+	assert.Equal(t, 1, custom.Compare(plain))
+	assert.Equal(t, -1, plain.Compare(custom))
+	assert.Equal(t, -1, custom.Compare(f.MustParse("2025.07-alpha")))
+}
+
+func TestRegistryNext(t *testing.T) {
+	r := calver.NewRegistry()
+	assert.NoError(t, r.Register(calver.CustomConvention{
+		Name:      "<BUILD>",
+		Level:     "micro",
+		Regex:     `b\d+`,
+		Initial:   "b1",
+		Increment: incBuild,
+	}))
+	assert.NoError(t, r.Register(calver.CustomConvention{Name: "<TAG>", Level: "micro", Regex: `[a-z]+`, Initial: "init"}))
+
+	f := r.MustCompileFormat("<YYYY>.<0M>.<BUILD>")
+	ver := f.MustParse("2025.07.b3")
+	next, err := ver.Next(date(2025, 8, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, "2025.08.b1", next.String())
+	next, err = ver.Next(date(2025, 7, 20))
+	assert.NoError(t, err)
+	assert.Equal(t, "2025.07.b4", next.String())
+
+	tagged := r.MustCompileFormat("<YYYY>.<0M>.<TAG>")
+	fresh, err := tagged.FromTime(date(2025, 7, 20))
+	assert.NoError(t, err)
+	assert.Equal(t, "2025.07.init", fresh.String())
+	_, err = fresh.Next(date(2025, 7, 21))
+	assert.ErrorIs(t, err, calver.ErrNoCounter)
+	next, err = fresh.Next(date(2025, 9, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, "2025.09.init", next.String())
+
+	assert.NoError(t, r.Register(calver.CustomConvention{Name: "<STAGE>", Level: "modifier", Regex: `[a-z]+`, Initial: "dev"}))
+	assert.NoError(t, r.Register(calver.CustomConvention{Name: "<SHA>", Level: "modifier", Regex: `[0-9a-f]{7}`}))
+	next, err = r.MustCompileFormat("<YYYY>.<0M>.<BUILD>-<STAGE>").MustParse("2025.07.b3-rc").Next(date(2025, 7, 20))
+	assert.NoError(t, err)
+	assert.Equal(t, "2025.07.b4-dev", next.String())
+	_, err = r.MustCompileFormat("<YYYY>.<0M>.<BUILD>-<SHA>").MustParse("2025.07.b3-1a2b3c4").Next(date(2025, 7, 20))
+	assert.ErrorIs(t, err, calver.ErrNoModifier)
+}
+
+// incBuild increments a build number of the form b1, b2 and so on.
+func incBuild(value string) (string, error) {
+	n, err := strconv.Atoi(strings.TrimPrefix(value, "b"))
+	if err != nil {
+		return "", err
+	}
+	return "b" + strconv.Itoa(n+1), nil
+}
+
+func TestRegistryInvalidIncrement(t *testing.T) {
+	r := calver.NewRegistry()
+	assert.NoError(t, r.Register(calver.CustomConvention{
+		Name:      "<BUILD>",
+		Level:     "micro",
+		Regex:     `b\d+`,
+		Initial:   "b1",
+		Increment: func(value string) (string, error) { return value + "+", nil },
+	}))
+
+	ver := r.MustCompileFormat("<YYYY>.<0M>.<BUILD>").MustParse("2025.07.b3")
+	_, err := ver.Next(date(2025, 7, 20))
+	assert.ErrorIs(t, err, calver.ErrInvalidConvention)
+	err = ver.IncLevel("micro", calver.BumpOptions{})
+	assert.ErrorIs(t, err, calver.ErrInvalidConvention)
+	assert.Equal(t, "2025.07.b3", ver.String())
+}
+
+func TestRegistryUnmarshal(t *testing.T) {
+	r := calver.NewRegistry()
+	assert.NoError(t, r.Register(calver.CustomConvention{Name: "<R>", Level: "micro", Regex: `r\d+`}))
+	ver := r.MustCompileFormat("<YYYY>.<0M>.<R>").MustParse("2025.07.r3")
+
+	text, err := ver.MarshalText()
+	assert.NoError(t, err)
+	assert.NoError(t, ver.UnmarshalText([]byte("2025.08.r4")))
+	assert.Equal(t, "2025.08.r4", ver.String())
+	assert.NoError(t, ver.UnmarshalText(text))
+	assert.Equal(t, "2025.07.r3", ver.String())
+
+	assert.NoError(t, ver.Scan("2025.09.r1"))
+	assert.Equal(t, "2025.09.r1", ver.String())
+	nv := calver.NullVersion{Version: ver}
+	assert.NoError(t, nv.Scan([]byte("2025.10.r2")))
+	assert.Equal(t, "2025.10.r2", nv.Version.String())
+
+	// Changing the format drops the registry.
+	ver.Format = "<YYYY>.<0M>.<MICRO>"
+	assert.NoError(t, ver.UnmarshalText([]byte("2025.07.3")))
+	assert.Equal(t, "2025.07.3", ver.String())
+}
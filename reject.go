@@ -0,0 +1,126 @@
+package calver
+
+import "fmt"
+
+// RejectPolicy is how NewCollectionLenient handles version strings that cannot
+// be parsed, see WithRejectPolicy.
+type RejectPolicy int
+
+const (
+	// RejectFailFast stops at the first version string that cannot be parsed.
+	// This is the default.
+	RejectFailFast RejectPolicy = iota
+	// RejectSkip skips every version string that cannot be parsed.
+	RejectSkip
+	// RejectSkipWithLimit skips version strings that cannot be parsed until
+	// more of them than the limit set with WithRejectLimit are rejected.
+	RejectSkipWithLimit
+)
+
+// WithRejectPolicy is a parse option that sets how NewCollectionLenient
+// handles version strings that cannot be parsed. It has no effect on the other
+// functions.
+func WithRejectPolicy(policy RejectPolicy) parseOption {
+	return func(options *parseOptions) {
+		options.rejectPolicy = policy
+	}
+}
+
+// WithRejectLimit is a parse option that sets the number of version strings
+// NewCollectionLenient may reject with the RejectSkipWithLimit policy. The
+// default limit is 0.
+func WithRejectLimit(limit int) parseOption {
+	return func(options *parseOptions) {
+		options.rejectLimit = limit
+	}
+}
+
+// Rejection is a version string that NewCollectionLenient could not parse. It
+// implements the error interface and unwraps to the parse error, so
+// errors.Is(rejection, ErrMismatch) reports whether the version string did not
+// match the formats.
+type Rejection struct {
+	// Index is the index of the version string in the input.
+	Index int
+	// Version is the rejected version string.
+	Version string
+	// Err is the error returned when parsing the version string.
+	Err error
+}
+
+func (r Rejection) Error() string {
+	return fmt.Sprintf("version %d %q rejected: %v", r.Index, r.Version, r.Err)
+}
+
+// Unwrap returns the parse error.
+func (r Rejection) Unwrap() error {
+	return r.Err
+}
+
+// NewCollectionLenient is like NewCollectionWithOptions but does not give up on
+// the version strings that cannot be parsed, such as "latest" or "nightly"
+// among the tags of a registry. It returns the collection of the versions that
+// were parsed, in their input order, along with the rejected version strings
+// and the reason they were rejected.
+//
+// What happens with a version string that cannot be parsed depends on the
+// policy set with WithRejectPolicy:
+//
+//   - RejectFailFast, the default, stops and returns its Rejection as the
+//     error.
+//   - RejectSkip skips it.
+//   - RejectSkipWithLimit skips it unless more version strings than the limit
+//     set with WithRejectLimit are rejected, in which case it stops and returns
+//     an error wrapping ErrTooManyRejections.
+//
+// When it stops, the returned collection and rejections are those up to and
+// including the version string that made it stop.
+//
+// Example:
+//
+//	collection, rejected, err := calver.NewCollectionLenient(
+//	    []string{"2025.07.14", "latest", "2025.07.15", "nightly"},
+//	    calver.WithFormat("<YYYY>.<0M>.<0D>"),
+//	    calver.WithRejectPolicy(calver.RejectSkip),
+//	)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(collection) // [2025.07.14 2025.07.15]
+//	for _, r := range rejected {
+//	    fmt.Println(r.Index, r.Version) // 1 latest, then 3 nightly
+//	}
+//
+// It returns an error without any collection if the parse options are
+// invalid, e.g. if no format is given.
+func NewCollectionLenient(versions []string, opts ...parseOption) (Collection, []Rejection, error) {
+	o, formats, err := newParseOptions(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	collection := Collection{}
+	var rejected []Rejection
+	for i, version := range versions {
+		ver, err := parseFormats(version, formats, o)
+		if err == nil {
+			collection = append(collection, ver)
+			continue
+		}
+		r := Rejection{Index: i, Version: version, Err: err}
+		rejected = append(rejected, r)
+		switch o.rejectPolicy {
+		case RejectSkip:
+			// Every rejection is tolerated.
+		case RejectSkipWithLimit:
+			if len(rejected) > o.rejectLimit {
+				return collection, rejected, fmt.Errorf(
+					"%w (limit %d): %w", ErrTooManyRejections, o.rejectLimit, r,
+				)
+			}
+		default:
+			return collection, rejected, r
+		}
+	}
+	return collection, rejected, nil
+}
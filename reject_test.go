@@ -0,0 +1,110 @@
+package calver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shazib-summar/go-calver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCollectionLenient(t *testing.T) {
+	versions := []string{"2025.07.14", "latest", "2025.07.15", "nightly", "2025.13.01", "2025.07.16"}
+	tests := []struct {
+		name         string
+		policy       calver.RejectPolicy
+		limit        int
+		want         []string
+		wantRejected []int
+		wantErr      error
+	}{
+		{
+			name:         "1",
+			policy:       calver.RejectSkip,
+			want:         []string{"2025.07.14", "2025.07.15", "2025.07.16"},
+			wantRejected: []int{1, 3, 4},
+		},
+		{
+			name:         "2",
+			policy:       calver.RejectFailFast,
+			want:         []string{"2025.07.14"},
+			wantRejected: []int{1},
+			wantErr:      calver.ErrMismatch,
+		},
+		{
+			name:         "3",
+			policy:       calver.RejectSkipWithLimit,
+			limit:        3,
+			want:         []string{"2025.07.14", "2025.07.15", "2025.07.16"},
+			wantRejected: []int{1, 3, 4},
+		},
+		{
+			name:         "4",
+			policy:       calver.RejectSkipWithLimit,
+			limit:        2,
+			want:         []string{"2025.07.14", "2025.07.15"},
+			wantRejected: []int{1, 3, 4},
+			wantErr:      calver.ErrTooManyRejections,
+		},
+		{
+			name:         "5",
+			policy:       calver.RejectSkipWithLimit,
+			want:         []string{"2025.07.14"},
+			wantRejected: []int{1},
+			wantErr:      calver.ErrTooManyRejections,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collection, rejected, err := calver.NewCollectionLenient(
+				versions,
+				calver.WithFormat("<YYYY>.<0M>.<0D>"),
+				calver.WithStrictCalendar(),
+				calver.WithRejectPolicy(tt.policy),
+				calver.WithRejectLimit(tt.limit),
+			)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, versionStrings(collection))
+			var indices []int
+			for _, r := range rejected {
+				indices = append(indices, r.Index)
+				assert.Equal(t, versions[r.Index], r.Version)
+				assert.Error(t, r.Err)
+			}
+			assert.Equal(t, tt.wantRejected, indices)
+		})
+	}
+}
+
+func TestNewCollectionLenientRejection(t *testing.T) {
+	_, rejected, err := calver.NewCollectionLenient(
+		[]string{"2025.07.14", "2025.02.30", "latest"},
+		calver.WithFormat("<YYYY>.<0M>.<0D>"),
+		calver.WithStrictCalendar(),
+		calver.WithRejectPolicy(calver.RejectSkip),
+	)
+	assert.NoError(t, err)
+	assert.Len(t, rejected, 2)
+	assert.ErrorIs(t, rejected[0], calver.ErrInvalidCalendar)
+	assert.ErrorIs(t, rejected[1], calver.ErrMismatch)
+
+	var me *calver.MismatchError
+	assert.True(t, errors.As(rejected[1], &me))
+	assert.Equal(t, "latest", me.Version)
+	assert.Equal(t, `version 2 "latest" rejected: `+me.Error(), rejected[1].Error())
+
+	collection, rejected, err := calver.NewCollectionLenient([]string{"2025.07.14"})
+	assert.ErrorIs(t, err, calver.ErrNoFormat)
+	assert.Nil(t, collection)
+	assert.Nil(t, rejected)
+
+	collection, rejected, err = calver.NewCollectionLenient(nil, calver.WithFormat("<YYYY>"))
+	assert.NoError(t, err)
+	assert.Empty(t, collection)
+	assert.Empty(t, rejected)
+}
@@ -0,0 +1,142 @@
+package calver
+
+import (
+	"slices"
+
+	"github.com/shazib-summar/go-calver/internal"
+)
+
+// Resolution is how ParseWithOptions chooses a format when a version string
+// matches several of the given formats, see WithResolution.
+type Resolution int
+
+const (
+	// ResolveMostSegments chooses the format capturing the most segments and,
+	// among those, the first one given. It is the default.
+	ResolveMostSegments Resolution = iota
+	// ResolveFirstMatch chooses the first matching format in the order the
+	// formats were given.
+	ResolveFirstMatch
+	// ResolveMostSpecific chooses the matching format with the highest Score,
+	// see FormatMatch, and among those the first one given.
+	ResolveMostSpecific
+	// ResolveErrorOnAmbiguity returns an *AmbiguityError if more than one
+	// format matches.
+	ResolveErrorOnAmbiguity
+)
+
+// WithResolution is a parse option that sets how a format is chosen when the
+// version string matches several formats. With WithStrictCalendar, formats
+// whose calendar values are invalid are not considered matching.
+//
+// Example:
+//
+//	ver, err := calver.ParseWithOptions(
+//	    "25.07.04",
+//	    calver.WithFormat("<YY>.<MM>.<DD>", "<0Y>.<0M>.<0D>"),
+//	    calver.WithResolution(calver.ResolveMostSpecific),
+//	)
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(ver.Format) // <0Y>.<0M>.<0D>
+func WithResolution(r Resolution) parseOption {
+	return func(options *parseOptions) {
+		options.resolution = r
+	}
+}
+
+// scoreWeight separates the criteria combined in FormatMatch.Score.
+const scoreWeight = 100
+
+// FormatMatch is a format matching a version string, see MatchFormats.
+type FormatMatch struct {
+	// Format is the matching format string.
+	Format string
+	// Version is the version string parsed with the format.
+	Version *Version
+	// Specificity is the number of segments captured from the version string,
+	// not counting free-form modifiers such as <MODIFIER>, which match any
+	// text.
+	Specificity int
+	// FixedWidth is the number of captured values whose convention has a
+	// fixed width, e.g. <YYYY> or <0M> rather than <YY> or <MM>.
+	FixedWidth int
+	// ValidCalendar reports whether the calendar values are a valid date, see
+	// WithStrictCalendar.
+	ValidCalendar bool
+	// Score combines the criteria above: a valid calendar outweighs any
+	// specificity, which outweighs any number of fixed width values. It is
+	// 10000 for a valid calendar plus 100 times Specificity plus FixedWidth.
+	Score int
+}
+
+// MatchFormats returns every format given by the parse options that the
+// version string matches, from the highest to the lowest Score. Matches of
+// equal score are in the order the formats were given. It returns no match,
+// and no error, if the version string matches none of the formats.
+//
+// Example:
+//
+//	matches, err := calver.MatchFormats(
+//	    "25.07.04",
+//	    calver.WithFormat("<YY>.<MM>.<DD>", "<0Y>.<0M>.<0D>", "<MAJOR>.<MINOR>.<MICRO>"),
+//	)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, m := range matches {
+//	    fmt.Println(m.Format, m.Score)
+//	}
+//	// <0Y>.<0M>.<0D> 10303
+//	// <YY>.<MM>.<DD> 10300
+//	// <MAJOR>.<MINOR>.<MICRO> 10300
+//
+// It returns an error if the parse options are invalid, e.g. if no format is
+// given or a format is malformed.
+func MatchFormats(version string, opts ...parseOption) ([]FormatMatch, error) {
+	_, formats, err := newParseOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []FormatMatch
+	for _, f := range formats {
+		values, _ := f.match(version)
+		if !slices.ContainsFunc(values, func(value string) bool { return value != "" }) {
+			continue
+		}
+		m := f.scoreMatch(version, values)
+		m.Version = newVersion(f, values)
+		matches = append(matches, m)
+	}
+	slices.SortStableFunc(matches, func(a, b FormatMatch) int {
+		return b.Score - a.Score
+	})
+	return matches, nil
+}
+
+// scoreMatch returns the FormatMatch of the format for the version string
+// given the values of its segments. Its Version is left nil.
+func (f *Format) scoreMatch(version string, values []string) FormatMatch {
+	m := FormatMatch{
+		Format:        f.raw,
+		ValidCalendar: f.validateCalendar(version, values) == nil,
+	}
+	for i, seg := range f.segments {
+		if values[i] == "" {
+			continue
+		}
+		if seg.convention.Kind != internal.KindModifier {
+			m.Specificity++
+		}
+		if seg.convention.FixedWidth() {
+			m.FixedWidth++
+		}
+	}
+	if m.ValidCalendar {
+		m.Score = scoreWeight * scoreWeight
+	}
+	m.Score += scoreWeight*m.Specificity + m.FixedWidth
+	return m
+}
@@ -0,0 +1,164 @@
+package calver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shazib-summar/go-calver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchFormats(t *testing.T) {
+	tests := []struct {
+		name       string
+		formats    []string
+		version    string
+		wantFormat []string
+		wantScore  []int
+	}{
+		{
+			name:       "1",
+			formats:    []string{"<YY>.<MM>.<DD>", "<0Y>.<0M>.<0D>", "<MAJOR>.<MINOR>.<MICRO>"},
+			version:    "25.07.04",
+			wantFormat: []string{"<0Y>.<0M>.<0D>", "<YY>.<MM>.<DD>", "<MAJOR>.<MINOR>.<MICRO>"},
+			wantScore:  []int{10303, 10300, 10300},
+		},
+		{
+			name:       "2",
+			formats:    []string{"<YYYY>.<0M>.<0D>", "<YYYY>.<0M>.<MICRO>"},
+			version:    "2025.02.30",
+			wantFormat: []string{"<YYYY>.<0M>.<MICRO>", "<YYYY>.<0M>.<0D>"},
+			wantScore:  []int{10302, 303},
+		},
+		{
+			name:       "3",
+			formats:    []string{"<YYYY>.<MODIFIER>", "<YYYY>.<0M>"},
+			version:    "2025.07",
+			wantFormat: []string{"<YYYY>.<0M>", "<YYYY>.<MODIFIER>"},
+			wantScore:  []int{10202, 10101},
+		},
+		{
+			name:       "4",
+			formats:    []string{"<YYYY>.<0M>.<0D>[.<MICRO>]", "<YYYY>.<0M>.<0D>"},
+			version:    "2025.07.14",
+			wantFormat: []string{"<YYYY>.<0M>.<0D>[.<MICRO>]", "<YYYY>.<0M>.<0D>"},
+			wantScore:  []int{10303, 10303},
+		},
+		{
+			name:    "5",
+			formats: []string{"<YYYY>.<0M>"},
+			version: "2025-07",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			matches, err := calver.MatchFormats(test.version, calver.WithFormat(test.formats...))
+			assert.NoError(t, err)
+			var formats []string
+			var scores []int
+			for _, m := range matches {
+				formats = append(formats, m.Format)
+				scores = append(scores, m.Score)
+				assert.Equal(t, test.version, m.Version.String())
+				assert.Equal(t, m.Format, m.Version.Format)
+			}
+			assert.Equal(t, test.wantFormat, formats)
+			assert.Equal(t, test.wantScore, scores)
+		})
+	}
+
+	matches, err := calver.MatchFormats("2025.02.30", calver.WithFormat("<YYYY>.<0M>.<0D>"))
+	assert.NoError(t, err)
+	assert.Equal(t, calver.FormatMatch{
+		Format:        "<YYYY>.<0M>.<0D>",
+		Version:       matches[0].Version,
+		Specificity:   3,
+		FixedWidth:    3,
+		ValidCalendar: false,
+		Score:         303,
+	}, matches[0])
+
+	_, err = calver.MatchFormats("2025.07")
+	assert.ErrorIs(t, err, calver.ErrNoFormat)
+	_, err = calver.MatchFormats("2025.07", calver.WithFormat("<YYYY>.<YY>"))
+	assert.ErrorIs(t, err, calver.ErrInvalidFormat)
+}
+
+func TestWithResolution(t *testing.T) {
+	tests := []struct {
+		name       string
+		formats    []string
+		version    string
+		resolution calver.Resolution
+		strict     bool
+		wantFormat string
+		wantErr    error
+	}{
+		{name: "1", formats: []string{"<YY>.<MM>.<DD>", "<0Y>.<0M>.<0D>"}, version: "25.07.04", resolution: calver.ResolveMostSegments, wantFormat: "<YY>.<MM>.<DD>"},
+		{name: "2", formats: []string{"<YY>.<MM>.<DD>", "<0Y>.<0M>.<0D>"}, version: "25.07.04", resolution: calver.ResolveMostSpecific, wantFormat: "<0Y>.<0M>.<0D>"},
+		{name: "3", formats: []string{"<YY>.<MM>.<DD>", "<0Y>.<0M>.<0D>"}, version: "25.07.04", resolution: calver.ResolveErrorOnAmbiguity, wantErr: calver.ErrAmbiguous},
+		{name: "4", formats: []string{"<YY>.<MM>.<DD>", "<0Y>.<0M>.<0D>"}, version: "25.7.4", resolution: calver.ResolveErrorOnAmbiguity, wantFormat: "<YY>.<MM>.<DD>"},
+		{name: "5", formats: []string{"<YYYY>", "<YYYY>.<0M>"}, version: "2025.07", resolution: calver.ResolveFirstMatch, wantFormat: "<YYYY>.<0M>"},
+		{name: "6", formats: []string{"<YYYY>.<MODIFIER>", "<YYYY>.<0M>"}, version: "2025.07", resolution: calver.ResolveFirstMatch, wantFormat: "<YYYY>.<MODIFIER>"},
+		{name: "7", formats: []string{"<YYYY>.<MODIFIER>", "<YYYY>.<0M>"}, version: "2025.07", resolution: calver.ResolveMostSpecific, wantFormat: "<YYYY>.<0M>"},
+		{name: "8", formats: []string{"<YYYY>.<0M>.<0D>", "<YYYY>.<0M>.<MICRO>"}, version: "2025.02.30", resolution: calver.ResolveMostSegments, wantFormat: "<YYYY>.<0M>.<0D>"},
+		{name: "9", formats: []string{"<YYYY>.<0M>.<0D>", "<YYYY>.<0M>.<MICRO>"}, version: "2025.02.30", resolution: calver.ResolveMostSpecific, wantFormat: "<YYYY>.<0M>.<MICRO>"},
+		{name: "10", formats: []string{"<YYYY>.<0M>.<0D>", "<YYYY>.<0M>.<MICRO>"}, version: "2025.02.30", resolution: calver.ResolveErrorOnAmbiguity, wantErr: calver.ErrAmbiguous},
+		{
+			name:       "11",
+			formats:    []string{"<YYYY>.<0M>.<0D>", "<YYYY>.<0M>.<MICRO>"},
+			version:    "2025.02.30",
+			resolution: calver.ResolveErrorOnAmbiguity,
+			strict:     true,
+			wantFormat: "<YYYY>.<0M>.<MICRO>",
+		},
+		{
+			name:       "12",
+			formats:    []string{"<YYYY>.<0M>.<0D>", "<YYYY>.<0M>.<MICRO>"},
+			version:    "2025.02.30",
+			resolution: calver.ResolveFirstMatch,
+			strict:     true,
+			wantFormat: "<YYYY>.<0M>.<MICRO>",
+		},
+		{name: "13", formats: []string{"<YYYY>.<0M>"}, version: "2025-07", resolution: calver.ResolveMostSpecific, wantErr: calver.ErrMismatch},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ver, err := calver.ParseWithOptions(
+				test.version,
+				calver.WithFormat(test.formats...),
+				calver.WithResolution(test.resolution),
+			)
+			if test.strict {
+				ver, err = calver.ParseWithOptions(
+					test.version,
+					calver.WithFormat(test.formats...),
+					calver.WithResolution(test.resolution),
+					calver.WithStrictCalendar(),
+				)
+			}
+			if test.wantErr != nil {
+				assert.ErrorIs(t, err, test.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantFormat, ver.Format)
+			assert.Equal(t, test.version, ver.String())
+		})
+	}
+}
+
+func TestAmbiguityError(t *testing.T) {
+	_, err := calver.ParseWithOptions(
+		"25.07",
+		calver.WithFormat("<YY>.<0M>", "<YYYY>.<0M>", "<0Y>.<0M>"),
+		calver.WithResolution(calver.ResolveErrorOnAmbiguity),
+	)
+	var ae *calver.AmbiguityError
+	assert.True(t, errors.As(err, &ae))
+	assert.Equal(t, "25.07", ae.Version)
+	assert.Equal(t, []string{"<YY>.<0M>", "<0Y>.<0M>"}, ae.Formats)
+	assert.NotErrorIs(t, err, calver.ErrMismatch)
+}
@@ -0,0 +1,142 @@
+package calver
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Scanner reads versions from an io.Reader, one per line by default, without
+// loading them all into memory. It is used like bufio.Scanner: Scan advances to
+// the next version, which is returned by Version, until the input is exhausted
+// or fails to be read, which is reported by Err.
+//
+// Surrounding white space is trimmed and blank lines are skipped. Comments can
+// be skipped with SkipComments.
+//
+// Example:
+//
+//	out, _ := exec.Command("git", "tag").Output()
+//	sc, err := calver.NewScanner(bytes.NewReader(out), calver.WithFormat("v<YYYY>.<0M>.<MICRO>"))
+//	if err != nil {
+//	    return err
+//	}
+//	for sc.Scan() {
+//	    ver, err := sc.Version()
+//	    if err != nil {
+//	        log.Println(err) // line 3: version "latest" does not match ...
+//	        continue
+//	    }
+//	    fmt.Println(ver)
+//	}
+//	if err := sc.Err(); err != nil {
+//	    return err
+//	}
+type Scanner struct {
+	scanner *bufio.Scanner
+	opts    *parseOptions
+	formats []*Format
+
+	skipBlank     bool
+	commentPrefix string
+
+	// line is the number of tokens read so far, including skipped ones.
+	line int
+	text string
+	ver  *Version
+	err  error
+}
+
+// NewScanner returns a Scanner reading versions from r and parsing them with
+// the given parse options.
+//
+// It returns an error if the parse options are invalid, e.g. if no format is
+// given.
+func NewScanner(r io.Reader, opts ...parseOption) (*Scanner, error) {
+	o, formats, err := newParseOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Scanner{
+		scanner:   bufio.NewScanner(r),
+		opts:      o,
+		formats:   formats,
+		skipBlank: true,
+	}, nil
+}
+
+// Split sets the split function of the scanner, see bufio.Scanner.Split. The
+// default is bufio.ScanLines, while bufio.ScanWords reads versions separated
+// by any white space. It must be called before Scan.
+func (s *Scanner) Split(split bufio.SplitFunc) {
+	s.scanner.Split(split)
+}
+
+// Buffer sets the buffer of the scanner and the maximum length of a token,
+// see bufio.Scanner.Buffer. It must be called before Scan.
+func (s *Scanner) Buffer(buf []byte, max int) {
+	s.scanner.Buffer(buf, max)
+}
+
+// SkipComments skips the tokens starting with prefix, e.g. "#", after
+// trimming. An empty prefix, which is the default, skips no comment. It must
+// be called before Scan.
+func (s *Scanner) SkipComments(prefix string) {
+	s.commentPrefix = prefix
+}
+
+// SkipBlank sets whether blank tokens are skipped, which is the default.
+// Otherwise they are parsed like any other token and fail to match the format.
+// It must be called before Scan.
+func (s *Scanner) SkipBlank(skip bool) {
+	s.skipBlank = skip
+}
+
+// Scan advances the scanner to the next token that is not skipped and parses
+// it. It returns false when the input is exhausted or cannot be read, but not
+// when the token cannot be parsed, which is reported by Version.
+func (s *Scanner) Scan() bool {
+	for s.scanner.Scan() {
+		s.line++
+		s.text = strings.TrimSpace(s.scanner.Text())
+		if s.text == "" && s.skipBlank {
+			continue
+		}
+		if s.commentPrefix != "" && strings.HasPrefix(s.text, s.commentPrefix) {
+			continue
+		}
+		s.ver, s.err = parseFormats(s.text, s.formats, s.opts)
+		if s.err != nil {
+			s.err = fmt.Errorf("line %d: %w", s.line, s.err)
+		}
+		return true
+	}
+	s.text, s.ver, s.err = "", nil, nil
+	return false
+}
+
+// Version returns the version read by the last call to Scan or an error if the
+// token could not be parsed. The error is the parse error prefixed with the
+// line, so it can be inspected with errors.Is and errors.As.
+func (s *Scanner) Version() (*Version, error) {
+	return s.ver, s.err
+}
+
+// Text returns the trimmed token read by the last call to Scan.
+func (s *Scanner) Text() string {
+	return s.text
+}
+
+// Line returns the number of the token read by the last call to Scan, i.e. its
+// line number with the default split function, starting at 1. Skipped tokens
+// are counted.
+func (s *Scanner) Line() int {
+	return s.line
+}
+
+// Err returns the first error encountered while reading the input, if any.
+// It does not report tokens that could not be parsed, see Version.
+func (s *Scanner) Err() error {
+	return s.scanner.Err()
+}
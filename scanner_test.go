@@ -0,0 +1,127 @@
+package calver_test
+
+import (
+	"bufio"
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/shazib-summar/go-calver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanner(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		comments  string
+		keepBlank bool
+		split     bufio.SplitFunc
+		want      []string
+		wantLines []int
+		wantErrs  []int
+	}{
+		{
+			name:      "1",
+			input:     "2025.07.14\n2025.07.15\n",
+			want:      []string{"2025.07.14", "2025.07.15"},
+			wantLines: []int{1, 2},
+		},
+		{
+			name:      "2",
+			input:     "2025.07.14\r\n\n  2025.07.15  \n\nlatest\n2025.07.16",
+			want:      []string{"2025.07.14", "2025.07.15", "", "2025.07.16"},
+			wantLines: []int{1, 3, 5, 6},
+			wantErrs:  []int{5},
+		},
+		{
+			name:      "3",
+			input:     "# releases\n2025.07.14\n  # hotfix\n2025.07.15\n",
+			comments:  "#",
+			want:      []string{"2025.07.14", "2025.07.15"},
+			wantLines: []int{2, 4},
+		},
+		{
+			name:      "4",
+			input:     "# releases\n2025.07.14\n",
+			want:      []string{"", "2025.07.14"},
+			wantLines: []int{1, 2},
+			wantErrs:  []int{1},
+		},
+		{
+			name:      "5",
+			input:     "2025.07.14\n\n2025.07.15\n",
+			keepBlank: true,
+			want:      []string{"2025.07.14", "", "2025.07.15"},
+			wantLines: []int{1, 2, 3},
+			wantErrs:  []int{2},
+		},
+		{
+			name:      "6",
+			input:     "2025.07.14 2025.07.15\t2025.07.16\n",
+			split:     bufio.ScanWords,
+			want:      []string{"2025.07.14", "2025.07.15", "2025.07.16"},
+			wantLines: []int{1, 2, 3},
+		},
+		{name: "7", input: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc, err := calver.NewScanner(strings.NewReader(tt.input), calver.WithFormat("<YYYY>.<0M>.<0D>"))
+			assert.NoError(t, err)
+			sc.SkipComments(tt.comments)
+			sc.SkipBlank(!tt.keepBlank)
+			if tt.split != nil {
+				sc.Split(tt.split)
+			}
+
+			var got []string
+			var lines, errLines []int
+			for sc.Scan() {
+				lines = append(lines, sc.Line())
+				ver, err := sc.Version()
+				if err != nil {
+					assert.ErrorIs(t, err, calver.ErrMismatch)
+					assert.Nil(t, ver)
+					errLines = append(errLines, sc.Line())
+					got = append(got, "")
+					continue
+				}
+				assert.Equal(t, sc.Text(), ver.String())
+				got = append(got, ver.String())
+			}
+			assert.NoError(t, sc.Err())
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantLines, lines)
+			assert.Equal(t, tt.wantErrs, errLines)
+		})
+	}
+}
+
+func TestScannerErrors(t *testing.T) {
+	_, err := calver.NewScanner(strings.NewReader("2025.07.14"))
+	assert.ErrorIs(t, err, calver.ErrNoFormat)
+
+	sc, err := calver.NewScanner(strings.NewReader("2025.07.14\nlatest\n"), calver.WithFormat("<YYYY>.<0M>.<0D>"))
+	assert.NoError(t, err)
+	assert.True(t, sc.Scan())
+	assert.True(t, sc.Scan())
+	_, err = sc.Version()
+	var me *calver.MismatchError
+	assert.True(t, errors.As(err, &me))
+	assert.Equal(t, "latest", me.Version)
+	assert.Equal(t, "line 2: "+me.Error(), err.Error())
+	assert.False(t, sc.Scan())
+
+	sc, err = calver.NewScanner(
+		iotest.TimeoutReader(strings.NewReader("2025.07.14\n2025.07.15\n")),
+		calver.WithFormat("<YYYY>.<0M>.<0D>"),
+	)
+	assert.NoError(t, err)
+	sc.Buffer(make([]byte, 4), 64)
+	for sc.Scan() {
+	}
+	assert.ErrorIs(t, sc.Err(), iotest.ErrTimeout)
+}
@@ -0,0 +1,137 @@
+package calver
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+
+	"github.com/shazib-summar/go-calver/internal"
+)
+
+// sortKeyWidth is the width numeric levels are padded to in a sort key. It is
+// large enough for any unsigned 64 bit integer.
+const sortKeyWidth = 20
+
+// Value implements the driver.Valuer interface. The version is stored as its
+// string representation.
+func (c *Version) Value() (driver.Value, error) {
+	return c.String(), nil
+}
+
+// Scan implements the sql.Scanner interface. The value is parsed like in
+// UnmarshalText, i.e. using the Format field of the version if it is set and
+// the formats set with SetDefaultFormats otherwise. Use NullVersion to scan
+// columns that may be NULL.
+func (c *Version) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return c.UnmarshalText([]byte(src))
+	case []byte:
+		return c.UnmarshalText(src)
+	case nil:
+		return fmt.Errorf("cannot scan NULL into a Version, use NullVersion instead")
+	}
+	return fmt.Errorf("cannot scan %T into a Version", src)
+}
+
+// NullVersion is a Version that may be NULL. It implements the sql.Scanner and
+// driver.Valuer interfaces so it can be used like sql.NullString.
+type NullVersion struct {
+	Version *Version
+	// Valid is true if Version is not NULL.
+	Valid bool
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullVersion) Scan(src any) error {
+	if src == nil {
+		n.Version, n.Valid = nil, false
+		return nil
+	}
+	ver := &Version{}
+	if n.Version != nil {
+		ver.Format, ver.format = n.Version.Format, n.Version.format
+	}
+	if err := ver.Scan(src); err != nil {
+		return err
+	}
+	n.Version, n.Valid = ver, true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullVersion) Value() (driver.Value, error) {
+	if !n.Valid || n.Version == nil {
+		return nil, nil
+	}
+	return n.Version.Value()
+}
+
+// SortKey returns a string that can be stored alongside the version so that
+// sorting by it, e.g. with ORDER BY in a database, gives the same order as
+// Compare. Keys of different versions are compared byte by byte.
+//
+// The major, minor and micro levels are zero-padded to 20 digits and missing
+// levels sort first. Numeric modifiers are zero-padded to 20 digits and sort
+// before non-numeric modifiers, which are compared as strings. The order of a
+// numeric and a non-numeric modifier may therefore differ from Compare, which
+// compares them as strings. The Compare functions of custom conventions, see
+// Registry, are not taken into account.
+//
+// Segments that are not the first of their level, see Segments, follow the
+// first one and sort after versions that lack them. They should not follow a
+// non-numeric modifier, whose length is not fixed.
+//
+// Example:
+//
+//	ver, _ := calver.Parse("<YYYY>.<0M>.<MICRO>", "2025.07.3")
+//	key, err := ver.SortKey()
+//	if err != nil {
+//	    return err
+//	}
+//	_, err = db.Exec("INSERT INTO releases (version, sort_key) VALUES (?, ?)", ver, key)
+//
+// It returns an error if a numeric value has more than 20 digits.
+func (c *Version) SortKey() (string, error) {
+	var key strings.Builder
+	for _, lv := range internal.ValidLevels {
+		for rank := range c.levelSegments(lv) {
+			value := c.valueAt(segmentKey{level: lv, rank: rank})
+			switch {
+			case rank > 0 && value == "":
+				continue
+			case value == "":
+				key.WriteString("0")
+				if lv != internal.KeyModifier {
+					key.WriteString(strings.Repeat("0", sortKeyWidth))
+				}
+			case isNumeric(value):
+				digits := strings.TrimLeft(value, "0")
+				if len(digits) > sortKeyWidth {
+					return "", fmt.Errorf(
+						"%s value %q of version %q has more than %d digits",
+						lv, value, c.String(), sortKeyWidth,
+					)
+				}
+				key.WriteByte(sortKeyMarker(rank, '1'))
+				key.WriteString(strings.Repeat("0", sortKeyWidth-len(digits)))
+				key.WriteString(digits)
+			default:
+				key.WriteByte(sortKeyMarker(rank, '2'))
+				key.WriteString(value)
+			}
+		}
+	}
+	return key.String(), nil
+}
+
+// sortKeyMarker returns the byte preceding a value in a sort key. The markers
+// of the segments that are not the first of their level are greater than the
+// markers of any first segment, so a version with such a segment sorts after
+// the versions without it.
+func sortKeyMarker(rank int, marker byte) byte {
+	if rank > 0 {
+		return marker + 2
+	}
+	return marker
+}
@@ -0,0 +1,169 @@
+package calver_test
+
+import (
+	"database/sql"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/shazib-summar/go-calver"
+	"github.com/stretchr/testify/assert"
+	_ "modernc.org/sqlite"
+)
+
+func TestVersionScanValue(t *testing.T) {
+	db, err := sql.Open("sqlite", ":memory:")
+	assert.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec("CREATE TABLE releases (version TEXT, previous TEXT)")
+	assert.NoError(t, err)
+
+	ver := calver.MustCompileFormat("<YYYY>.<0M>.<0D>").MustParse("2025.07.14")
+	_, err = db.Exec("INSERT INTO releases VALUES (?, ?)", ver, calver.NullVersion{})
+	assert.NoError(t, err)
+
+	got := &calver.Version{Format: "<YYYY>.<0M>.<0D>"}
+	var previous calver.NullVersion
+	err = db.QueryRow("SELECT version, previous FROM releases").Scan(got, &previous)
+	assert.NoError(t, err)
+	assert.True(t, ver.Equal(got))
+	assert.Equal(t, ver.Format, got.Format)
+	assert.False(t, previous.Valid)
+
+	previous = calver.NullVersion{Version: &calver.Version{Format: "<YYYY>.<0M>.<0D>"}}
+	err = db.QueryRow("SELECT version, version FROM releases").Scan(got, &previous)
+	assert.NoError(t, err)
+	assert.True(t, previous.Valid)
+	assert.True(t, ver.Equal(previous.Version))
+
+	err = db.QueryRow("SELECT previous FROM releases").Scan(got)
+	assert.Error(t, err)
+}
+
+func TestVersionScan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		want    string
+		wantErr bool
+	}{
+		{name: "1", src: "2025.07.14", want: "2025.07.14"},
+		{name: "2", src: []byte("2025.07.14"), want: "2025.07.14"},
+		{name: "3", src: "2025.07", wantErr: true},
+		{name: "4", src: nil, wantErr: true},
+		{name: "5", src: int64(2025), wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ver := &calver.Version{Format: "<YYYY>.<0M>.<0D>"}
+			err := ver.Scan(test.src)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, ver.String())
+		})
+	}
+}
+
+func TestVersionSortKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		versions []string
+	}{
+		{
+			name:     "1",
+			format:   "<YYYY>.<0M>.<MICRO>",
+			versions: []string{"2025.07.10", "2025.07.9", "2024.12.0", "2025.10.1", "2025.07.100"},
+		},
+		{
+			name:     "2",
+			format:   "<YYYY>.<0M>.<0D>-<MODIFIER>",
+			versions: []string{"2025.07.14-rc", "2025.07.14-beta", "2025.07.14-", "2025.07.13-rc"},
+		},
+		{
+			name:     "3",
+			format:   "<YYYY>.<0M>.<0D>-<MODIFIER>",
+			versions: []string{"2025.07.14-10", "2025.07.14-9", "2025.07.14-011", "2025.07.14-"},
+		},
+		{
+			name:     "4",
+			format:   "<0Y>.<MINOR>",
+			versions: []string{"24.10", "24.9", "09.1", "24.0"},
+		},
+		{
+			name:     "5",
+			format:   "<YYYY>.<0M>.<0D>.<MICRO>",
+			versions: []string{"2025.07.14.10", "2025.07.14.9", "2025.07.13.11", "2025.08.01.0"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db, err := sql.Open("sqlite", ":memory:")
+			assert.NoError(t, err)
+			defer db.Close()
+
+			_, err = db.Exec("CREATE TABLE releases (version TEXT, sort_key TEXT)")
+			assert.NoError(t, err)
+			for _, v := range test.versions {
+				ver := calver.MustCompileFormat(test.format).MustParse(v)
+				key, err := ver.SortKey()
+				assert.NoError(t, err)
+				_, err = db.Exec("INSERT INTO releases VALUES (?, ?)", ver, key)
+				assert.NoError(t, err)
+			}
+
+			rows, err := db.Query("SELECT version FROM releases ORDER BY sort_key")
+			assert.NoError(t, err)
+			defer rows.Close()
+			var got []string
+			for rows.Next() {
+				ver := &calver.Version{Format: test.format}
+				assert.NoError(t, rows.Scan(ver))
+				got = append(got, ver.String())
+			}
+			assert.NoError(t, rows.Err())
+
+			collection, err := calver.NewCollection(test.format, test.versions...)
+			assert.NoError(t, err)
+			collection.SortWith()
+			var want []string
+			for _, ver := range collection {
+				want = append(want, ver.String())
+			}
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestVersionSortKeyTooLong(t *testing.T) {
+	ver := calver.MustCompileFormat("<YYYY>.<MINOR>").MustParse("2025." + strings.Repeat("9", 21))
+	_, err := ver.SortKey()
+	assert.Error(t, err)
+}
+
+func TestVersionSortKeySegments(t *testing.T) {
+	formats := calver.WithFormat("<YYYY>.<0M>.<0D>", "<YYYY>.<0M>.<0D>.<MICRO>", "<YYYY>.<0M>.<0D>-<MODIFIER>")
+	versions := []string{"2025.07.14.2", "2025.07.14-rc", "2025.07.14", "2025.07.14.10", "2025.07.15"}
+	var keys []string
+	byKey := map[string]string{}
+	for _, v := range versions {
+		ver, err := calver.ParseWithOptions(v, formats)
+		assert.NoError(t, err)
+		key, err := ver.SortKey()
+		assert.NoError(t, err)
+		keys = append(keys, key)
+		byKey[key] = v
+	}
+	slices.Sort(keys)
+	var got []string
+	for _, key := range keys {
+		got = append(got, byKey[key])
+	}
+	assert.Equal(t, []string{"2025.07.14", "2025.07.14-rc", "2025.07.14.2", "2025.07.14.10", "2025.07.15"}, got)
+}